@@ -0,0 +1,59 @@
+// Package auth проверяет токены доступа (JWT) и передаёт вызывающего
+// пользователя обработчикам через контекст запроса.
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnauthenticated  = errors.New("требуется аутентификация")
+	ErrInvalidToken     = errors.New("недействительный токен доступа")
+	ErrMethodNotAllowed = errors.New("метод недоступен для роли пользователя")
+)
+
+// Role — роль пользователя из токена доступа.
+type Role string
+
+const (
+	RoleClient    Role = "client"
+	RoleMaster    Role = "master"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// Valid сообщает, известна ли роль сервису.
+func (r Role) Valid() bool {
+	switch r {
+	case RoleClient, RoleMaster, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Identity — пользователь, выполняющий запрос.
+type Identity struct {
+	UserID uuid.UUID
+	Role   Role
+}
+
+// IsAdmin сообщает, является ли пользователь администратором.
+func (id Identity) IsAdmin() bool {
+	return id.Role == RoleAdmin
+}
+
+type identityKey struct{}
+
+// NewContext возвращает контекст с пользователем id.
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext возвращает пользователя, выполняющего запрос, если он известен.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Interceptor проверяет токен из метаданных authorization ("Bearer <jwt>"),
+// сверяет роль пользователя с политикой и кладёт пользователя в контекст
+// запроса. Ошибки возвращаются как ErrUnauthenticated, ErrInvalidToken и
+// ErrMethodNotAllowed; переводить их в статусы gRPC должен перехватчик ошибок,
+// стоящий раньше в цепочке.
+type Interceptor struct {
+	verifier *Verifier
+	policy   *Policy
+	public   []string
+}
+
+// NewInterceptor создаёт перехватчик. Методы, полное имя которых начинается с
+// одного из префиксов public (например, "/grpc.health.v1.Health/"), доступны без токена.
+func NewInterceptor(verifier *Verifier, policy *Policy, public ...string) *Interceptor {
+	return &Interceptor{verifier: verifier, policy: policy, public: public}
+}
+
+func (i *Interceptor) isPublic(method string) bool {
+	for _, p := range i.public {
+		if strings.HasPrefix(method, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate возвращает контекст с пользователем из токена запроса, если его
+// роли разрешён метод.
+func (i *Interceptor) authenticate(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return nil, ErrUnauthenticated
+	}
+	scheme, token, ok := strings.Cut(vals[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, ErrUnauthenticated
+	}
+	id, err := i.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("enduser.id", id.UserID.String()),
+		attribute.String("enduser.role", string(id.Role)),
+	)
+	if !i.policy.AllowMethod(id.Role, method) {
+		return nil, ErrMethodNotAllowed
+	}
+	return NewContext(ctx, id), nil
+}
+
+func (i *Interceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if i.isPublic(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	ctx, err := i.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *Interceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if i.isPublic(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream подменяет контекст потока контекстом с пользователем.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
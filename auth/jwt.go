@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	secretMethods = []string{"HS256", "HS384", "HS512"}
+	publicMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// claims — утверждения токена доступа: ID пользователя в sub и его роль в role.
+type claims struct {
+	jwt.RegisteredClaims
+	Role Role `json:"role"`
+}
+
+// Verifier проверяет подпись и срок действия токенов доступа.
+type Verifier struct {
+	parser  *jwt.Parser
+	keyfunc jwt.Keyfunc
+}
+
+// NewVerifier создаёт Verifier по настройкам cfg: токены подписываются либо
+// общим секретом (HS*), либо ключами из файла JWKS (RS*, PS*, ES*).
+func NewVerifier(cfg config.Auth) (*Verifier, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Duration(cfg.Leeway)),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	v := &Verifier{}
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		v.keyfunc = keys.keyfunc
+		opts = append(opts, jwt.WithValidMethods(publicMethods))
+	} else {
+		secret := []byte(cfg.Secret)
+		v.keyfunc = func(*jwt.Token) (any, error) { return secret, nil }
+		opts = append(opts, jwt.WithValidMethods(secretMethods))
+	}
+	v.parser = jwt.NewParser(opts...)
+	return v, nil
+}
+
+// Verify проверяет токен и возвращает пользователя из него.
+func (v *Verifier) Verify(token string) (Identity, error) {
+	var c claims
+	if _, err := v.parser.ParseWithClaims(token, &c, v.keyfunc); err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	id, err := uuid.Parse(c.Subject)
+	if err != nil || id == uuid.Nil {
+		return Identity{}, fmt.Errorf("%w: sub не является UUID пользователя", ErrInvalidToken)
+	}
+	if !c.Role.Valid() {
+		return Identity{}, fmt.Errorf("%w: неизвестная роль %q", ErrInvalidToken, c.Role)
+	}
+	return Identity{UserID: id, Role: c.Role}, nil
+}
+
+// jwk — открытый ключ в формате JSON Web Key (RFC 7517).
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks map[string]crypto.PublicKey
+
+// loadJWKS читает открытые ключи подписи из файла JWKS. Ключи с use, отличным
+// от sig, и ключи неподдерживаемых типов пропускаются.
+func loadJWKS(path string) (jwks, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("jwks: %w", err)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("jwks %s: %w", path, err)
+	}
+
+	keys := make(jwks, len(set.Keys))
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		var key crypto.PublicKey
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsa()
+		case "EC":
+			key, err = k.ecdsa()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jwks %s: key %d (kid %q): %w", path, i, k.Kid, err)
+		}
+		if _, dup := keys[k.Kid]; dup {
+			return nil, fmt.Errorf("jwks %s: duplicate kid %q", path, k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks %s: no signing keys", path)
+	}
+	return keys, nil
+}
+
+// keyfunc выбирает ключ по kid из заголовка токена. Токен без kid
+// принимается, только если ключ в наборе один.
+func (keys jwks) keyfunc(t *jwt.Token) (any, error) {
+	kid, _ := t.Header["kid"].(string)
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	key, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	return key, nil
+}
+
+func (k jwk) rsa() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil || len(n) == 0 {
+		return nil, errors.New("invalid RSA modulus")
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	if key.N.BitLen() < 2048 {
+		return nil, fmt.Errorf("RSA key is too short: %d bits", key.N.BitLen())
+	}
+	return key, nil
+}
+
+func (k jwk) ecdsa() (*ecdsa.PublicKey, error) {
+	var (
+		curve elliptic.Curve
+		check ecdh.Curve
+	)
+	switch k.Crv {
+	case "P-256":
+		curve, check = elliptic.P256(), ecdh.P256()
+	case "P-384":
+		curve, check = elliptic.P384(), ecdh.P384()
+	case "P-521":
+		curve, check = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+	size := (curve.Params().BitSize + 7) / 8
+	x, errX := base64.RawURLEncoding.DecodeString(k.X)
+	y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+	if errX != nil || errY != nil || len(x) != size || len(y) != size {
+		return nil, errors.New("invalid EC coordinates")
+	}
+	// Точка проверяется через crypto/ecdh: ключ вне кривой отклоняется.
+	point := append(append([]byte{4}, x...), y...)
+	if _, err := check.NewPublicKey(point); err != nil {
+		return nil, errors.New("EC point is not on the curve")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+}
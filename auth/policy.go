@@ -0,0 +1,145 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Ostap00034/course-work-backend-order-service/config"
+	"google.golang.org/grpc"
+)
+
+// Policy определяет, какие роли могут вызывать методы API и переводить заказ
+// между статусами. Всё, что не разрешено явно, запрещено. Администратору
+// разрешено всё независимо от политики.
+type Policy struct {
+	methods     map[string]map[Role]bool
+	transitions map[string]map[Role]bool
+}
+
+// NewPolicy создаёт политику по настройкам cfg и проверяет имена ролей и
+// ключи переходов вида "from->to".
+func NewPolicy(cfg config.Policy) (*Policy, error) {
+	var errs []error
+	parse := func(section string, rules map[string][]string) map[string]map[Role]bool {
+		out := make(map[string]map[Role]bool, len(rules))
+		for key, roles := range rules {
+			set := make(map[Role]bool, len(roles))
+			for _, r := range roles {
+				role := Role(r)
+				if !role.Valid() {
+					errs = append(errs, fmt.Errorf("policy.%s[%q]: неизвестная роль %q", section, key, r))
+					continue
+				}
+				set[role] = true
+			}
+			out[key] = set
+		}
+		return out
+	}
+
+	p := &Policy{
+		methods:     parse("methods", cfg.Methods),
+		transitions: parse("transitions", cfg.Transitions),
+	}
+	for key := range p.methods {
+		if service, method, ok := strings.Cut(key, "/"); !ok || service == "" || method == "" {
+			errs = append(errs, fmt.Errorf("policy.methods[%q]: ожидается ключ вида Service/Method", key))
+		}
+	}
+	for key := range p.transitions {
+		if from, to, ok := strings.Cut(key, "->"); !ok || from == "" || to == "" {
+			errs = append(errs, fmt.Errorf("policy.transitions[%q]: ожидается ключ вида from->to", key))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// methodKey превращает полное имя метода gRPC ("/order.v1.OrderService/GetOrders")
+// в ключ политики ("OrderService/GetOrders").
+func methodKey(fullMethod string) string {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if i := strings.LastIndexByte(service, '.'); i >= 0 {
+		service = service[i+1:]
+	}
+	return service + "/" + method
+}
+
+func transitionKey(from, to string) string {
+	return from + "->" + to
+}
+
+// AllowMethod сообщает, может ли роль вызывать метод fullMethod.
+func (p *Policy) AllowMethod(role Role, fullMethod string) bool {
+	return role == RoleAdmin || p.methods[methodKey(fullMethod)][role]
+}
+
+// AllowTransition сообщает, может ли роль перевести заказ из статуса from в to.
+func (p *Policy) AllowTransition(role Role, from, to string) bool {
+	return role == RoleAdmin || p.transitions[transitionKey(from, to)][role]
+}
+
+// CheckMethods сверяет политику с сервисами gRPC: у каждого метода должно быть
+// правило, и каждое правило должно относиться к существующему методу.
+func (p *Policy) CheckMethods(services ...*grpc.ServiceDesc) error {
+	known := make(map[string]bool)
+	for _, sd := range services {
+		for _, m := range sd.Methods {
+			known[methodKey("/"+sd.ServiceName+"/"+m.MethodName)] = true
+		}
+		for _, s := range sd.Streams {
+			known[methodKey("/"+sd.ServiceName+"/"+s.StreamName)] = true
+		}
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(known) {
+		if _, ok := p.methods[key]; !ok {
+			errs = append(errs, fmt.Errorf("policy.methods: нет правила для %s", key))
+		}
+	}
+	for _, key := range sortedKeys(p.methods) {
+		if !known[key] {
+			errs = append(errs, fmt.Errorf("policy.methods: неизвестный метод %s", key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CheckTransitions сверяет политику с допустимыми переходами статусов (from →
+// список to): у каждого перехода должно быть правило, и каждое правило должно
+// относиться к допустимому переходу.
+func (p *Policy) CheckTransitions(allowed map[string][]string) error {
+	known := make(map[string]bool)
+	for from, tos := range allowed {
+		for _, to := range tos {
+			known[transitionKey(from, to)] = true
+		}
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(known) {
+		if _, ok := p.transitions[key]; !ok {
+			errs = append(errs, fmt.Errorf("policy.transitions: нет правила для %s", key))
+		}
+	}
+	for _, key := range sortedKeys(p.transitions) {
+		if !known[key] {
+			errs = append(errs, fmt.Errorf("policy.transitions: недопустимый переход %s", key))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,151 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/config"
+	orderextpbv1 "github.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1"
+	order "github.com/Ostap00034/course-work-backend-order-service/internal"
+	"google.golang.org/grpc"
+)
+
+var services = []*grpc.ServiceDesc{
+	&orderpbv1.OrderService_ServiceDesc,
+	&orderextpbv1.OrderExtService_ServiceDesc,
+	&orderextpbv1.OrderAdminService_ServiceDesc,
+}
+
+func defaultPolicy(t *testing.T) *auth.Policy {
+	t.Helper()
+	p, err := auth.NewPolicy(config.Default().Policy)
+	if err != nil {
+		t.Fatalf("NewPolicy(default) error = %v", err)
+	}
+	return p
+}
+
+func TestDefaultPolicyCoversAPI(t *testing.T) {
+	p := defaultPolicy(t)
+	if err := p.CheckMethods(services...); err != nil {
+		t.Errorf("CheckMethods() = %v", err)
+	}
+	if err := p.CheckTransitions(order.Transitions()); err != nil {
+		t.Errorf("CheckTransitions() = %v", err)
+	}
+}
+
+func TestDefaultPolicyMethods(t *testing.T) {
+	p := defaultPolicy(t)
+	tests := []struct {
+		role   auth.Role
+		method string
+		want   bool
+	}{
+		{auth.RoleClient, "/order.v1.OrderService/CreateOrder", true},
+		{auth.RoleMaster, "/order.v1.OrderService/CreateOrder", false},
+		{auth.RoleModerator, "/order.v1.OrderService/GetOrderById", true},
+		{auth.RoleModerator, "/order.v1.OrderService/UpdateOrder", false},
+		{auth.RoleMaster, "/orderext.v1.OrderExtService/CreateOffer", true},
+		{auth.RoleClient, "/orderext.v1.OrderExtService/CreateOffer", false},
+		{auth.RoleMaster, "/orderext.v1.OrderExtService/AcceptMasterTransfer", true},
+		{auth.RoleClient, "/orderext.v1.OrderExtService/ReassignOrderMaster", true},
+		{auth.RoleMaster, "/orderext.v1.OrderExtService/ReassignOrderMaster", false},
+		{auth.RoleModerator, "/orderext.v1.OrderAdminService/HardDeleteOrder", false},
+		{auth.RoleClient, "/orderext.v1.OrderAdminService/ListAllOrders", false},
+		{auth.RoleAdmin, "/orderext.v1.OrderAdminService/ListAllOrders", true},
+		{auth.RoleAdmin, "/order.v1.OrderService/Unknown", true},
+		{auth.RoleClient, "/order.v1.OrderService/Unknown", false},
+	}
+	for _, tt := range tests {
+		if got := p.AllowMethod(tt.role, tt.method); got != tt.want {
+			t.Errorf("AllowMethod(%s, %s) = %v, want %v", tt.role, tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultPolicyTransitions(t *testing.T) {
+	p := defaultPolicy(t)
+	tests := []struct {
+		role     auth.Role
+		from, to string
+		want     bool
+	}{
+		{auth.RoleClient, "active", "in_progress", true},
+		{auth.RoleClient, "active", "cancel", true},
+		{auth.RoleClient, "in_progress", "done", false},
+		{auth.RoleMaster, "in_progress", "done", true},
+		{auth.RoleMaster, "in_progress", "cancel", false},
+		{auth.RoleModerator, "active", "cancel", false},
+		{auth.RoleAdmin, "done", "active", true},
+	}
+	for _, tt := range tests {
+		if got := p.AllowTransition(tt.role, tt.from, tt.to); got != tt.want {
+			t.Errorf("AllowTransition(%s, %s, %s) = %v, want %v", tt.role, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCheckMethodsReportsGaps(t *testing.T) {
+	cfg := config.Default().Policy
+	methods := make(map[string][]string, len(cfg.Methods))
+	for k, v := range cfg.Methods {
+		methods[k] = v
+	}
+	delete(methods, "OrderService/DeleteOrder")
+	methods["OrderService/DropOrders"] = []string{"client"}
+
+	p, err := auth.NewPolicy(config.Policy{Methods: methods, Transitions: cfg.Transitions})
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+	err = p.CheckMethods(services...)
+	if err == nil {
+		t.Fatal("CheckMethods() = nil, want errors")
+	}
+	for _, want := range []string{"нет правила для OrderService/DeleteOrder", "неизвестный метод OrderService/DropOrders"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("CheckMethods() = %q, want it to mention %q", err, want)
+		}
+	}
+}
+
+func TestCheckTransitionsReportsGaps(t *testing.T) {
+	p, err := auth.NewPolicy(config.Policy{Transitions: map[string][]string{
+		"active->in_progress": {"client"},
+		"done->active":        {"client"},
+	}})
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+	err = p.CheckTransitions(order.Transitions())
+	if err == nil {
+		t.Fatal("CheckTransitions() = nil, want errors")
+	}
+	for _, want := range []string{"нет правила для in_progress->done", "недопустимый переход done->active"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("CheckTransitions() = %q, want it to mention %q", err, want)
+		}
+	}
+}
+
+func TestNewPolicyRejectsInvalidRules(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Policy
+	}{
+		{"unknown role", config.Policy{Methods: map[string][]string{"OrderService/GetOrders": {"guest"}}}},
+		{"method key without service", config.Policy{Methods: map[string][]string{"GetOrders": {"client"}}}},
+		{"transition key without arrow", config.Policy{Transitions: map[string][]string{"active": {"client"}}}},
+		{"transition key without target", config.Policy{Transitions: map[string][]string{"active->": {"client"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := auth.NewPolicy(tt.cfg); err == nil {
+				t.Error("NewPolicy() error = nil, want error")
+			}
+		})
+	}
+}
@@ -1,19 +1,41 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
 	userpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/user/v1"
 
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/config"
 	"github.com/Ostap00034/course-work-backend-order-service/db"
+	orderextpbv1 "github.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/healthcheck"
 	order "github.com/Ostap00034/course-work-backend-order-service/internal"
+	"github.com/Ostap00034/course-work-backend-order-service/lifecycle"
+	"github.com/Ostap00034/course-work-backend-order-service/tracing"
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 func init() {
@@ -22,39 +44,222 @@ func init() {
 	}
 }
 
+// userTransportCredentials возвращает учётные данные соединения с сервисом пользователей.
+func userTransportCredentials(cfg config.UserService) (credentials.TransportCredentials, error) {
+	if !cfg.TLS {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg := &tls.Config{ServerName: cfg.ServerName, MinVersion: tls.VersionTLS12}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// serveMetrics отдаёт метрики reg по HTTP на /metrics до отмены ctx.
+func serveMetrics(ctx context.Context, addr string, reg *prometheus.Registry) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	errc := make(chan error, 1)
+	go func() { errc <- srv.ListenAndServe() }()
+	log.Printf("metrics are served on %s/metrics", addr)
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
+
 func main() {
-	dbString, ok := os.LookupEnv("ORDER_DB_CONN_STRING")
-	if !ok {
-		log.Fatal("ORDER_DB_CONN_STRING is not set")
+	cfg, opts, err := config.Load(os.Args[1:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		log.Fatalf("invalid configuration:\n%v", err)
+	}
+	if len(opts.Args) > 0 {
+		if opts.Args[0] != "migrate" {
+			log.Fatalf("unknown command %q", opts.Args[0])
+		}
+		if err := cfg.DB.Validate(); err != nil {
+			log.Fatalf("invalid configuration:\n%v", err)
+		}
+		if err := runMigrate(cfg.DB, opts.Args[1:]); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				os.Exit(0)
+			}
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+	// Конфигурация печатается до проверки, чтобы по ней можно было найти ошибку.
+	if opts.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatalf("failed to print configuration: %v", err)
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("invalid configuration:\n%v", err)
+	}
+	if opts.PrintConfig {
+		return
+	}
+
+	policy, err := auth.NewPolicy(cfg.Policy)
+	if err != nil {
+		log.Fatalf("invalid access policy: %v", err)
+	}
+	// Политика должна покрывать все методы и переходы: неупомянутое запрещено всем, кроме администратора.
+	err = errors.Join(
+		policy.CheckMethods(&orderpbv1.OrderService_ServiceDesc, &orderextpbv1.OrderExtService_ServiceDesc, &orderextpbv1.OrderAdminService_ServiceDesc),
+		policy.CheckTransitions(order.Transitions()),
+	)
+	if err != nil {
+		log.Fatalf("invalid access policy: %v", err)
 	}
-	client := db.NewClient(dbString)
-	defer client.Close()
+
+	lc := lifecycle.New(time.Duration(cfg.Shutdown.DrainTimeout), time.Duration(cfg.Shutdown.WorkerTimeout))
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+
+	client := db.NewClient(cfg.DB, reg)
 
 	repo := order.NewRepo(client)
-	svc := order.NewService(repo)
+	svc := order.NewService(repo, policy)
+	metrics := order.NewMetrics(reg, repo)
+
+	if cfg.Features.Purge {
+		lc.Go("purge", func(ctx context.Context) error {
+			order.RunPurge(ctx, svc, time.Duration(cfg.Purge.Interval), time.Duration(cfg.Purge.Retention))
+			return nil
+		})
+	}
+	// Изменения заказов доставляются подписчикам WatchOrders через outbox.
+	// С features.watch_notify события расходятся через PostgreSQL LISTEN/NOTIFY,
+	// чтобы их получали все экземпляры сервиса.
+	watch := order.NewBroadcaster(order.DefaultSubscriberBuffer)
+	var relay *order.Relay
+	if cfg.Features.WatchNotify {
+		relay = order.NewRelay(repo, order.MultiPublisher{order.LogPublisher{}, order.NewNotifyPublisher(repo), metrics})
+		lc.Go("order events listener", order.NewListener(cfg.DB.DSN, repo, watch).Run)
+	} else {
+		relay = order.NewRelay(repo, order.MultiPublisher{order.LogPublisher{}, watch, metrics})
+	}
+	lc.Go("outbox relay", func(ctx context.Context) error {
+		relay.Run(ctx)
+		return nil
+	})
 
-	userAddr, ok := os.LookupEnv("USER_SERVICE_ADDR")
-	if !ok {
-		log.Fatal("USER_SERVICE_ADDR is not set")
+	userCreds, err := userTransportCredentials(cfg.UserService)
+	if err != nil {
+		log.Fatalf("failed to configure UserService TLS: %v", err)
 	}
 	userConn, err := grpc.NewClient(
-		userAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		cfg.UserService.Addr,
+		grpc.WithTransportCredentials(userCreds),
+		grpc.WithChainUnaryInterceptor(metrics.UserClientInterceptor),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	)
 	if err != nil {
 		log.Fatalf("failed to dial UserService: %v", err)
 	}
-	defer userConn.Close()
-	userSvc := userpbv1.NewUserServiceClient(userConn)
+	userClient := order.NewResilientUserClient(userpbv1.NewUserServiceClient(userConn))
+	userClient.Timeout = time.Duration(cfg.UserService.CallTimeout)
+	userClient.MaxAttempts = cfg.UserService.CallMaxAttempts
+	userClient.BreakerCooldown = time.Duration(cfg.UserService.BreakerCooldown)
+	metrics.RegisterUserClient(reg, userClient)
+	users := order.NewUserResolver(
+		userClient,
+		time.Duration(cfg.UserService.CacheTTL),
+		time.Duration(cfg.UserService.LookupTimeout),
+		order.DefaultUserLookupConcurrency,
+	)
+
+	verifier, err := auth.NewVerifier(cfg.Auth)
+	if err != nil {
+		log.Fatalf("failed to set up authentication: %v", err)
+	}
+	// Проверки состояния и reflection доступны без токена.
+	authn := auth.NewInterceptor(verifier, policy, "/grpc.health.v1.Health/", "/grpc.reflection.")
 
-	lis, err := net.Listen("tcp", ":50054")
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	grpcSrv := grpc.NewServer()
-	srv := order.NewServer(svc, userSvc)
+	grpcSrv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor, order.UnaryErrorInterceptor, authn.Unary),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor, order.StreamErrorInterceptor, authn.Stream),
+	)
+	srv := order.NewServer(svc, users, watch)
 	orderpbv1.RegisterOrderServiceServer(grpcSrv, srv)
+	orderextpbv1.RegisterOrderExtServiceServer(grpcSrv, srv)
+	orderextpbv1.RegisterOrderAdminServiceServer(grpcSrv, order.NewAdminServer(svc, users))
+
+	if cfg.Features.Reflection {
+		reflection.Register(grpcSrv)
+	}
 
-	log.Println("OrderService is listening on :50054")
-	log.Fatal(grpcSrv.Serve(lis))
+	// Готовность сервиса определяется доступностью Postgres. Без сервиса
+	// пользователей заказы отдаются без данных пользователей, поэтому его
+	// состояние публикуется отдельно и на готовность не влияет.
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(grpcSrv, healthSrv)
+	checker := healthcheck.New(healthSrv, "", orderpbv1.OrderService_ServiceDesc.ServiceName, orderextpbv1.OrderExtService_ServiceDesc.ServiceName,
+		orderextpbv1.OrderAdminService_ServiceDesc.ServiceName)
+	checker.Interval = time.Duration(cfg.Health.Interval)
+	checker.Timeout = time.Duration(cfg.Health.Timeout)
+	checker.Add(healthcheck.Postgres, true, func(ctx context.Context) error {
+		return db.Ping(ctx, client)
+	})
+	userConnCheck := healthcheck.ConnCheck(userConn)
+	checker.Add(healthcheck.UserService, false, func(ctx context.Context) error {
+		if userClient.BreakerOpen() {
+			return order.ErrUserServiceUnavailable
+		}
+		return userConnCheck(ctx)
+	})
+	lc.Go("health checker", checker.Run)
+
+	if cfg.Metrics.Addr != "" {
+		lc.Go("metrics server", func(ctx context.Context) error {
+			return serveMetrics(ctx, cfg.Metrics.Addr, reg)
+		})
+	}
+
+	// Потоки WatchOrders бесконечны: без закрытия подписок GracefulStop ждал бы их до таймаута.
+	lc.BeforeDrain(watch.Close)
+	lc.OnClose("database", client.Close)
+	lc.OnClose("UserService connection", userConn.Close)
+	lc.OnClose("tracing", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return shutdownTracing(ctx)
+	})
+
+	log.Printf("OrderService is listening on %s", cfg.ListenAddr)
+	if err := lc.Serve(grpcSrv, lis, healthSrv); err != nil {
+		log.Fatalf("OrderService stopped: %v", err)
+	}
+	log.Println("OrderService stopped")
 }
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/config"
+	"github.com/Ostap00034/course-work-backend-order-service/db"
+)
+
+const migrateUsage = `usage: api [flags] migrate [--dry-run] <command>
+
+commands:
+  up        apply all pending migrations
+  down [N]  roll back the last N applied migrations (default 1)
+  status    list migrations and when they were applied
+`
+
+// runMigrate выполняет команду migrate с аргументами args.
+func runMigrate(cfg config.DB, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "print SQL instead of executing it")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), migrateUsage)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("missing migrate command")
+	}
+
+	conn, err := db.Open(cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	m, err := db.NewMigrator(conn)
+	if err != nil {
+		return err
+	}
+	m.DryRun = *dryRun
+
+	ctx := context.Background()
+	switch cmd := fs.Arg(0); cmd {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			return err
+		}
+		if !m.DryRun {
+			fmt.Printf("applied %d migration(s)\n", n)
+		}
+	case "down":
+		steps := 1
+		if fs.NArg() > 1 {
+			steps, err = strconv.Atoi(fs.Arg(1))
+			if err != nil || steps < 1 {
+				return fmt.Errorf("down: invalid number of migrations %q", fs.Arg(1))
+			}
+		}
+		n, err := m.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		if !m.DryRun {
+			fmt.Printf("rolled back %d migration(s)\n", n)
+		}
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.Applied() {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+	default:
+		fs.Usage()
+		return errors.New("unknown migrate command " + strconv.Quote(cmd))
+	}
+	return nil
+}
@@ -0,0 +1,547 @@
+// Package config загружает настройки сервиса заказов.
+//
+// Значения берутся по возрастанию приоритета: значения по умолчанию, файл
+// конфигурации (YAML или TOML, --config или ORDER_CONFIG_FILE), переменные
+// окружения и флаги командной строки.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Duration — длительность, которая в файле и переменных окружения записывается
+// строкой вида "1h30m".
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("неправильная длительность %q", text)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+type Config struct {
+	// ListenAddr — адрес, на котором сервис принимает gRPC-запросы.
+	ListenAddr  string      `yaml:"listen_addr" toml:"listen_addr"`
+	DB          DB          `yaml:"db" toml:"db"`
+	UserService UserService `yaml:"user_service" toml:"user_service"`
+	Auth        Auth        `yaml:"auth" toml:"auth"`
+	Policy      Policy      `yaml:"policy" toml:"policy"`
+	Purge       Purge       `yaml:"purge" toml:"purge"`
+	Shutdown    Shutdown    `yaml:"shutdown" toml:"shutdown"`
+	Health      Health      `yaml:"health" toml:"health"`
+	Metrics     Metrics     `yaml:"metrics" toml:"metrics"`
+	Tracing     Tracing     `yaml:"tracing" toml:"tracing"`
+	Features    Features    `yaml:"features" toml:"features"`
+}
+
+type DB struct {
+	DSN             string   `yaml:"dsn" toml:"dsn"`
+	MaxOpenConns    int      `yaml:"max_open_conns" toml:"max_open_conns"`
+	MaxIdleConns    int      `yaml:"max_idle_conns" toml:"max_idle_conns"`
+	ConnMaxLifetime Duration `yaml:"conn_max_lifetime" toml:"conn_max_lifetime"`
+	ConnMaxIdleTime Duration `yaml:"conn_max_idle_time" toml:"conn_max_idle_time"`
+	// AutoMigrate создаёт схему по ent при запуске вместо версионных миграций.
+	// Только для разработки: без него сервис не запустится, пока не выполнен migrate up.
+	AutoMigrate bool `yaml:"auto_migrate" toml:"auto_migrate"`
+}
+
+type UserService struct {
+	Addr string `yaml:"addr" toml:"addr"`
+	// TLS включает TLS для соединения с сервисом пользователей. Без CAFile
+	// сертификат проверяется по системным корневым сертификатам.
+	TLS             bool     `yaml:"tls" toml:"tls"`
+	CAFile          string   `yaml:"ca_file" toml:"ca_file"`
+	ServerName      string   `yaml:"server_name" toml:"server_name"`
+	CallTimeout     Duration `yaml:"call_timeout" toml:"call_timeout"`
+	CallMaxAttempts int      `yaml:"call_max_attempts" toml:"call_max_attempts"`
+	BreakerCooldown Duration `yaml:"breaker_cooldown" toml:"breaker_cooldown"`
+	LookupTimeout   Duration `yaml:"lookup_timeout" toml:"lookup_timeout"`
+	CacheTTL        Duration `yaml:"cache_ttl" toml:"cache_ttl"`
+}
+
+// publishedSecrets — секреты, когда-либо приведённые в примерах конфигурации.
+// Ими может подписать токен кто угодно, поэтому запускаться с ними нельзя.
+var publishedSecrets = map[string]bool{
+	"dev-secret-change-me-0123456789abcdef": true,
+}
+
+type Auth struct {
+	// JWKSFile — файл JWKS с открытыми ключами для проверки подписи токенов
+	// (RS*, PS*, ES*). Задаётся либо он, либо Secret.
+	JWKSFile string `yaml:"jwks_file" toml:"jwks_file"`
+	// Secret — общий секрет для токенов, подписанных HS256/HS384/HS512.
+	Secret   string `yaml:"secret" toml:"secret"`
+	Issuer   string `yaml:"issuer" toml:"issuer"`
+	Audience string `yaml:"audience" toml:"audience"`
+	// Leeway — допустимое расхождение часов при проверке срока действия токена.
+	Leeway Duration `yaml:"leeway" toml:"leeway"`
+}
+
+// Policy — роли, которым разрешены методы API и переходы статусов заказа.
+// Записи из файла дополняют и заменяют значения по умолчанию по ключу;
+// пустой список запрещает метод всем, кроме администратора.
+type Policy struct {
+	// Methods: "OrderService/UpdateOrder" → ["client", "master"].
+	Methods map[string][]string `yaml:"methods" toml:"methods"`
+	// Transitions: "in_progress->done" → ["master"].
+	Transitions map[string][]string `yaml:"transitions" toml:"transitions"`
+}
+
+type Purge struct {
+	Retention Duration `yaml:"retention" toml:"retention"`
+	Interval  Duration `yaml:"interval" toml:"interval"`
+}
+
+type Shutdown struct {
+	// DrainTimeout — сколько ждать завершения текущих запросов перед принудительной остановкой.
+	DrainTimeout Duration `yaml:"drain_timeout" toml:"drain_timeout"`
+	// WorkerTimeout — сколько ждать остановки фоновых задач.
+	WorkerTimeout Duration `yaml:"worker_timeout" toml:"worker_timeout"`
+}
+
+type Health struct {
+	// Interval — период проверки зависимостей.
+	Interval Duration `yaml:"interval" toml:"interval"`
+	// Timeout — время на одну проверку зависимости.
+	Timeout Duration `yaml:"timeout" toml:"timeout"`
+}
+
+type Metrics struct {
+	// Addr — адрес HTTP-сервера с /metrics; пустой адрес отключает метрики.
+	Addr string `yaml:"addr" toml:"addr"`
+}
+
+type Tracing struct {
+	// Exporter — куда отправлять трассы: otlp, stdout или none.
+	Exporter     string  `yaml:"exporter" toml:"exporter"`
+	OTLPEndpoint string  `yaml:"otlp_endpoint" toml:"otlp_endpoint"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" toml:"otlp_insecure"`
+	ServiceName  string  `yaml:"service_name" toml:"service_name"`
+	SampleRatio  float64 `yaml:"sample_ratio" toml:"sample_ratio"`
+}
+
+type Features struct {
+	// Purge включает фоновое окончательное удаление заказов.
+	Purge bool `yaml:"purge" toml:"purge"`
+	// WatchNotify рассылает изменения заказов через PostgreSQL LISTEN/NOTIFY,
+	// чтобы их получали подписчики WatchOrders всех экземпляров сервиса.
+	WatchNotify bool `yaml:"watch_notify" toml:"watch_notify"`
+	// Reflection включает gRPC server reflection (для grpcurl и подобных инструментов).
+	Reflection bool `yaml:"reflection" toml:"reflection"`
+}
+
+// Default возвращает конфигурацию со значениями по умолчанию.
+func Default() *Config {
+	return &Config{
+		ListenAddr: ":50054",
+		DB: DB{
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: Duration(30 * time.Minute),
+			ConnMaxIdleTime: Duration(5 * time.Minute),
+		},
+		UserService: UserService{
+			CallTimeout:     Duration(time.Second),
+			CallMaxAttempts: 3,
+			BreakerCooldown: Duration(30 * time.Second),
+			LookupTimeout:   Duration(2 * time.Second),
+			CacheTTL:        Duration(5 * time.Minute),
+		},
+		Auth: Auth{
+			Leeway: Duration(30 * time.Second),
+		},
+		Policy: Policy{
+			Methods: map[string][]string{
+				"OrderService/CreateOrder":         {"client"},
+				"OrderService/GetOrders":           {"client", "master", "moderator"},
+				"OrderService/GetOrderById":        {"client", "master", "moderator"},
+				"OrderService/UpdateOrder":         {"client", "master"},
+				"OrderService/DeleteOrder":         {"client"},
+				"OrderService/GetMyOrders":         {"client", "master", "moderator"},
+				"OrderService/GetMyFinishedOrders": {"client", "master", "moderator"},
+
+				"OrderExtService/GetOrderTimeline": {"client", "master", "moderator"},
+				"OrderExtService/GetNearbyOrders":  {"master", "moderator"},
+				"OrderExtService/SearchOrders":     {"client", "master", "moderator"},
+				"OrderExtService/CreateOffer":      {"master"},
+				"OrderExtService/GetOrderOffers":   {"client", "master", "moderator"},
+				"OrderExtService/AcceptOffer":      {"client"},
+				"OrderExtService/RejectOffer":      {"client"},
+				"OrderExtService/RestoreOrder":     {"client"},
+				"OrderExtService/WatchOrders":      {"client", "master", "moderator"},
+
+				"OrderExtService/UnassignMaster":        {"client", "master"},
+				"OrderExtService/ReassignOrderMaster":   {"client"},
+				"OrderExtService/RequestMasterTransfer": {"master"},
+				"OrderExtService/AcceptMasterTransfer":  {"master"},
+				"OrderExtService/DeclineMasterTransfer": {"master"},
+
+				"OrderAdminService/ForceOrderStatus": {"admin"},
+				"OrderAdminService/ReassignMaster":   {"admin"},
+				"OrderAdminService/HardDeleteOrder":  {"admin"},
+				"OrderAdminService/ListAllOrders":    {"admin"},
+			},
+			Transitions: map[string][]string{
+				"active->in_progress": {"client"},
+				"active->cancel":      {"client"},
+				"in_progress->done":   {"master"},
+				"in_progress->cancel": {"client"},
+			},
+		},
+		Purge: Purge{
+			Retention: Duration(30 * 24 * time.Hour),
+			Interval:  Duration(time.Hour),
+		},
+		Shutdown: Shutdown{
+			DrainTimeout:  Duration(20 * time.Second),
+			WorkerTimeout: Duration(10 * time.Second),
+		},
+		Health: Health{
+			Interval: Duration(10 * time.Second),
+			Timeout:  Duration(2 * time.Second),
+		},
+		Metrics: Metrics{
+			Addr: ":9090",
+		},
+		Tracing: Tracing{
+			Exporter:     "none",
+			OTLPEndpoint: "localhost:4317",
+			ServiceName:  "order-service",
+			SampleRatio:  1,
+		},
+		Features: Features{
+			Purge: true,
+		},
+	}
+}
+
+// setting связывает поле конфигурации с переменной окружения и флагом.
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	value any
+}
+
+func (c *Config) settings() []setting {
+	return []setting{
+		{"ORDER_LISTEN_ADDR", "listen-addr", "адрес gRPC-сервера", &c.ListenAddr},
+
+		{"ORDER_DB_CONN_STRING", "db-dsn", "строка подключения к PostgreSQL", &c.DB.DSN},
+		{"ORDER_DB_MAX_OPEN_CONNS", "db-max-open-conns", "максимум открытых соединений с БД (0 — без ограничения)", &c.DB.MaxOpenConns},
+		{"ORDER_DB_MAX_IDLE_CONNS", "db-max-idle-conns", "максимум простаивающих соединений с БД", &c.DB.MaxIdleConns},
+		{"ORDER_DB_CONN_MAX_LIFETIME", "db-conn-max-lifetime", "время жизни соединения с БД", &c.DB.ConnMaxLifetime},
+		{"ORDER_DB_CONN_MAX_IDLE_TIME", "db-conn-max-idle-time", "время простоя соединения с БД до закрытия", &c.DB.ConnMaxIdleTime},
+		{"ORDER_DB_AUTO_MIGRATE", "db-auto-migrate", "создавать схему БД при запуске без версионных миграций (только для разработки)", &c.DB.AutoMigrate},
+
+		{"USER_SERVICE_ADDR", "user-addr", "адрес сервиса пользователей", &c.UserService.Addr},
+		{"USER_SERVICE_TLS", "user-tls", "подключаться к сервису пользователей по TLS", &c.UserService.TLS},
+		{"USER_SERVICE_CA_FILE", "user-ca-file", "файл CA для проверки сертификата сервиса пользователей", &c.UserService.CAFile},
+		{"USER_SERVICE_SERVER_NAME", "user-server-name", "имя сервера в сертификате сервиса пользователей", &c.UserService.ServerName},
+		{"USER_CALL_TIMEOUT", "user-call-timeout", "таймаут одного вызова сервиса пользователей", &c.UserService.CallTimeout},
+		{"USER_CALL_MAX_ATTEMPTS", "user-call-max-attempts", "число попыток читающего вызова сервиса пользователей", &c.UserService.CallMaxAttempts},
+		{"USER_BREAKER_COOLDOWN", "user-breaker-cooldown", "время, на которое размыкается выключатель сервиса пользователей", &c.UserService.BreakerCooldown},
+		{"USER_LOOKUP_TIMEOUT", "user-lookup-timeout", "время на получение пользователей для одного ответа", &c.UserService.LookupTimeout},
+		{"USER_CACHE_TTL", "user-cache-ttl", "время хранения пользователей в кэше", &c.UserService.CacheTTL},
+
+		{"ORDER_AUTH_JWKS_FILE", "auth-jwks-file", "файл JWKS с ключами проверки токенов доступа", &c.Auth.JWKSFile},
+		{"ORDER_AUTH_SECRET", "auth-secret", "общий секрет токенов доступа (HS256)", &c.Auth.Secret},
+		{"ORDER_AUTH_ISSUER", "auth-issuer", "ожидаемый издатель (iss) токенов доступа", &c.Auth.Issuer},
+		{"ORDER_AUTH_AUDIENCE", "auth-audience", "ожидаемая аудитория (aud) токенов доступа", &c.Auth.Audience},
+		{"ORDER_AUTH_LEEWAY", "auth-leeway", "допустимое расхождение часов при проверке токенов", &c.Auth.Leeway},
+
+		{"ORDER_PURGE_RETENTION", "purge-retention", "через сколько удалённые заказы удаляются окончательно", &c.Purge.Retention},
+		{"ORDER_PURGE_INTERVAL", "purge-interval", "период окончательного удаления заказов", &c.Purge.Interval},
+
+		{"ORDER_SHUTDOWN_DRAIN_TIMEOUT", "shutdown-drain-timeout", "сколько ждать завершения запросов при остановке", &c.Shutdown.DrainTimeout},
+		{"ORDER_SHUTDOWN_WORKER_TIMEOUT", "shutdown-worker-timeout", "сколько ждать остановки фоновых задач", &c.Shutdown.WorkerTimeout},
+
+		{"ORDER_HEALTH_INTERVAL", "health-interval", "период проверки зависимостей", &c.Health.Interval},
+		{"ORDER_HEALTH_TIMEOUT", "health-timeout", "время на одну проверку зависимости", &c.Health.Timeout},
+
+		{"ORDER_METRICS_ADDR", "metrics-addr", "адрес HTTP-сервера с /metrics (пусто — выключен)", &c.Metrics.Addr},
+
+		{"ORDER_TRACING_EXPORTER", "tracing-exporter", "экспортёр трасс: otlp, stdout или none", &c.Tracing.Exporter},
+		{"ORDER_TRACING_OTLP_ENDPOINT", "tracing-otlp-endpoint", "адрес OTLP/gRPC-коллектора", &c.Tracing.OTLPEndpoint},
+		{"ORDER_TRACING_OTLP_INSECURE", "tracing-otlp-insecure", "подключаться к коллектору без TLS", &c.Tracing.OTLPInsecure},
+		{"ORDER_TRACING_SERVICE_NAME", "tracing-service-name", "имя сервиса в трассах", &c.Tracing.ServiceName},
+		{"ORDER_TRACING_SAMPLE_RATIO", "tracing-sample-ratio", "доля записываемых трасс от 0 до 1", &c.Tracing.SampleRatio},
+
+		{"ORDER_PURGE_ENABLED", "feature-purge", "включить окончательное удаление заказов", &c.Features.Purge},
+		{"ORDER_WATCH_NOTIFY", "feature-watch-notify", "рассылать изменения заказов через LISTEN/NOTIFY", &c.Features.WatchNotify},
+		{"ORDER_REFLECTION", "feature-reflection", "включить gRPC server reflection", &c.Features.Reflection},
+	}
+}
+
+// set разбирает строку s в поле, на которое указывает value.
+func set(value any, s string) error {
+	switch v := value.(type) {
+	case *string:
+		*v = s
+	case *int:
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return fmt.Errorf("ожидается целое число, получено %q", s)
+		}
+		*v = n
+	case *float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("ожидается число, получено %q", s)
+		}
+		*v = f
+	case *bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("ожидается true или false, получено %q", s)
+		}
+		*v = b
+	case *Duration:
+		return v.UnmarshalText([]byte(s))
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", value))
+	}
+	return nil
+}
+
+// Options — результат разбора командной строки, не относящийся к настройкам.
+type Options struct {
+	// PrintConfig — вывести итоговую конфигурацию и завершиться.
+	PrintConfig bool
+	// Args — аргументы после флагов: команда и её параметры.
+	Args []string
+}
+
+// Load собирает конфигурацию из значений по умолчанию, файла, окружения и
+// аргументов командной строки args (без имени программы). Конфигурация не
+// проверяется: разным командам нужны разные настройки, поэтому Validate или
+// DB.Validate вызывает вызывающий.
+func Load(args []string) (*Config, *Options, error) {
+	cfg := Default()
+	opts := &Options{}
+
+	fs := flag.NewFlagSet("order-service", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("ORDER_CONFIG_FILE"), "файл конфигурации (.yaml, .yml или .toml)")
+	fs.BoolVar(&opts.PrintConfig, "print-config", false, "вывести итоговую конфигурацию и завершиться")
+	settings := cfg.settings()
+	flags := make(map[string]string)
+	for _, s := range settings {
+		fs.Func(s.flag, s.usage+" ($"+s.env+")", func(v string) error {
+			flags[s.flag] = v
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+	opts.Args = fs.Args()
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var errs []error
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(s.env); ok {
+			if err := set(s.value, v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", s.env, err))
+			}
+		}
+	}
+	for _, s := range settings {
+		if v, ok := flags[s.flag]; ok {
+			if err := set(s.value, v); err != nil {
+				errs = append(errs, fmt.Errorf("--%s: %w", s.flag, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+	return cfg, opts, nil
+}
+
+// loadFile читает настройки из файла path; формат определяется по расширению.
+// Неизвестные ключи считаются ошибкой.
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("файл конфигурации: %w", err)
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(f)
+		dec.KnownFields(true)
+		if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("файл конфигурации %s: %w", path, err)
+		}
+	case ".toml":
+		md, err := toml.NewDecoder(f).Decode(c)
+		if err != nil {
+			return fmt.Errorf("файл конфигурации %s: %w", path, err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("файл конфигурации %s: неизвестные ключи %v", path, undecoded)
+		}
+	default:
+		return fmt.Errorf("файл конфигурации %s: неподдерживаемый формат %q", path, ext)
+	}
+	return nil
+}
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки сразу.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("listen_addr: неправильный адрес %q", c.ListenAddr))
+	}
+
+	if err := c.DB.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	check(c.UserService.Addr != "", "user_service.addr: не задан адрес сервиса пользователей (USER_SERVICE_ADDR)")
+	if c.UserService.CAFile != "" {
+		check(c.UserService.TLS, "user_service.ca_file: задан без user_service.tls")
+		if _, err := os.Stat(c.UserService.CAFile); err != nil {
+			errs = append(errs, fmt.Errorf("user_service.ca_file: %w", err))
+		}
+	}
+	check(c.UserService.CallTimeout > 0, "user_service.call_timeout: должен быть положительным")
+	check(c.UserService.CallMaxAttempts >= 1, "user_service.call_max_attempts: должно быть не меньше 1")
+	check(c.UserService.BreakerCooldown > 0, "user_service.breaker_cooldown: должен быть положительным")
+	check(c.UserService.LookupTimeout > 0, "user_service.lookup_timeout: должен быть положительным")
+	check(c.UserService.CacheTTL > 0, "user_service.cache_ttl: должен быть положительным")
+
+	switch {
+	case c.Auth.JWKSFile == "" && c.Auth.Secret == "":
+		errs = append(errs, errors.New("auth: не задан ни auth.jwks_file, ни auth.secret"))
+	case c.Auth.JWKSFile != "" && c.Auth.Secret != "":
+		errs = append(errs, errors.New("auth: auth.jwks_file и auth.secret взаимоисключающие"))
+	case c.Auth.JWKSFile != "":
+		if _, err := os.Stat(c.Auth.JWKSFile); err != nil {
+			errs = append(errs, fmt.Errorf("auth.jwks_file: %w", err))
+		}
+	default:
+		check(len(c.Auth.Secret) >= 32, "auth.secret: должен быть не короче 32 байт")
+		check(!publishedSecrets[c.Auth.Secret], "auth.secret: опубликованный секрет из примера, задайте свой")
+	}
+	check(c.Auth.Leeway >= 0, "auth.leeway: не может быть отрицательным")
+
+	check(c.Purge.Retention > 0, "purge.retention: должен быть положительным")
+	check(c.Purge.Interval > 0, "purge.interval: должен быть положительным")
+
+	check(c.Shutdown.DrainTimeout > 0, "shutdown.drain_timeout: должен быть положительным")
+	check(c.Shutdown.WorkerTimeout > 0, "shutdown.worker_timeout: должен быть положительным")
+
+	check(c.Health.Interval > 0, "health.interval: должен быть положительным")
+	check(c.Health.Timeout > 0, "health.timeout: должен быть положительным")
+
+	if c.Metrics.Addr != "" {
+		if _, _, err := net.SplitHostPort(c.Metrics.Addr); err != nil {
+			errs = append(errs, fmt.Errorf("metrics.addr: неправильный адрес %q", c.Metrics.Addr))
+		}
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		check(c.Tracing.OTLPEndpoint != "", "tracing.otlp_endpoint: не задан адрес коллектора")
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: неизвестный экспортёр %q (otlp, stdout или none)", c.Tracing.Exporter))
+	}
+	check(c.Tracing.ServiceName != "", "tracing.service_name: не задано имя сервиса")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio: должно быть от 0 до 1")
+
+	return errors.Join(errs...)
+}
+
+// Validate проверяет настройки базы данных — всё, что нужно команде migrate.
+func (d *DB) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(d.DSN != "", "db.dsn: не задана строка подключения (ORDER_DB_CONN_STRING)")
+	check(d.MaxOpenConns >= 0, "db.max_open_conns: не может быть отрицательным")
+	check(d.MaxIdleConns >= 0, "db.max_idle_conns: не может быть отрицательным")
+	check(d.MaxOpenConns == 0 || d.MaxIdleConns <= d.MaxOpenConns,
+		"db.max_idle_conns: не может превышать db.max_open_conns (%d)", d.MaxOpenConns)
+	check(d.ConnMaxLifetime >= 0, "db.conn_max_lifetime: не может быть отрицательным")
+	check(d.ConnMaxIdleTime >= 0, "db.conn_max_idle_time: не может быть отрицательным")
+
+	return errors.Join(errs...)
+}
+
+// Redacted возвращает копию конфигурации со скрытыми секретами.
+func (c *Config) Redacted() *Config {
+	r := *c
+	r.DB.DSN = redactDSN(c.DB.DSN)
+	if r.Auth.Secret != "" {
+		r.Auth.Secret = redacted
+	}
+	return &r
+}
+
+const redacted = "REDACTED"
+
+// redactDSN скрывает пароль в строке подключения к PostgreSQL в форме URL
+// или key=value.
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+		}
+		return u.String()
+	}
+	fields := strings.Fields(dsn)
+	for i, f := range fields {
+		if k, _, ok := strings.Cut(f, "="); ok && k == "password" {
+			fields[i] = "password=" + redacted
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// Print выводит конфигурацию в формате YAML со скрытыми секретами.
+func (c *Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.Redacted()); err != nil {
+		return err
+	}
+	return enc.Close()
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPolicyFileExtendsDefaults(t *testing.T) {
+	tests := []struct {
+		name, file, content string
+	}{
+		{"yaml", "config.yaml", `
+policy:
+  methods:
+    OrderService/DeleteOrder: [client, moderator]
+    OrderExtService/NewMethod: [master]
+  transitions:
+    in_progress->cancel: [client, master]
+`},
+		{"toml", "config.toml", `
+[policy.methods]
+"OrderService/DeleteOrder" = ["client", "moderator"]
+"OrderExtService/NewMethod" = ["master"]
+
+[policy.transitions]
+"in_progress->cancel" = ["client", "master"]
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _, err := Load([]string{"--config", writeConfig(t, tt.file, tt.content)})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			def := Default().Policy
+
+			methods := cfg.Policy.Methods
+			if got := methods["OrderService/DeleteOrder"]; !reflect.DeepEqual(got, []string{"client", "moderator"}) {
+				t.Errorf("DeleteOrder roles = %v, want overridden [client moderator]", got)
+			}
+			if got := methods["OrderExtService/NewMethod"]; !reflect.DeepEqual(got, []string{"master"}) {
+				t.Errorf("NewMethod roles = %v, want added [master]", got)
+			}
+			if len(methods) != len(def.Methods)+1 {
+				t.Errorf("len(methods) = %d, want defaults plus one (%d)", len(methods), len(def.Methods)+1)
+			}
+			for key, roles := range def.Methods {
+				if key == "OrderService/DeleteOrder" {
+					continue
+				}
+				if !reflect.DeepEqual(methods[key], roles) {
+					t.Errorf("methods[%q] = %v, want default %v", key, methods[key], roles)
+				}
+			}
+
+			transitions := cfg.Policy.Transitions
+			if got := transitions["in_progress->cancel"]; !reflect.DeepEqual(got, []string{"client", "master"}) {
+				t.Errorf("in_progress->cancel roles = %v, want overridden [client master]", got)
+			}
+			for key, roles := range def.Transitions {
+				if key != "in_progress->cancel" && !reflect.DeepEqual(transitions[key], roles) {
+					t.Errorf("transitions[%q] = %v, want default %v", key, transitions[key], roles)
+				}
+			}
+		})
+	}
+}
+
+// Пример конфигурации перечисляет политику по умолчанию целиком и не должен
+// от неё отставать.
+func TestExamplePolicyMatchesDefaults(t *testing.T) {
+	cfg, _, err := Load([]string{"--config", filepath.Join("..", "config.example.yaml")})
+	if err != nil {
+		t.Fatalf("Load(config.example.yaml) error = %v", err)
+	}
+	if !reflect.DeepEqual(cfg.Policy, Default().Policy) {
+		t.Errorf("config.example.yaml policy = %v, want defaults %v", cfg.Policy, Default().Policy)
+	}
+}
+
+func TestValidateAuthSecret(t *testing.T) {
+	tests := []struct {
+		name, secret string
+		ok           bool
+	}{
+		{"missing", "", false},
+		{"short", "0123456789", false},
+		{"published example", "dev-secret-change-me-0123456789abcdef", false},
+		{"own secret", "b1f0c3a9d8e7f6a5b4c3d2e1f0a9b8c7", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.DB.DSN = "postgres://localhost/orders"
+			cfg.UserService.Addr = "localhost:50052"
+			cfg.Auth.Secret = tt.secret
+			err := cfg.Validate()
+			if tt.ok && err != nil {
+				t.Errorf("Validate() = %v, want nil", err)
+			}
+			if !tt.ok && (err == nil || !strings.Contains(err.Error(), "auth")) {
+				t.Errorf("Validate() = %v, want auth error", err)
+			}
+		})
+	}
+}
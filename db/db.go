@@ -2,20 +2,88 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"log"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/config"
 	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	_ "github.com/Ostap00034/course-work-backend-order-service/ent/runtime"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 )
 
-func NewClient(dsn string) *ent.Client {
-	client, err := ent.Open("postgres", dsn)
+// Open открывает пул соединений с PostgreSQL с настройками cfg.
+func Open(cfg config.DB) (*sql.DB, error) {
+	db, err := sql.Open("postgres", cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetime))
+	db.SetConnMaxIdleTime(time.Duration(cfg.ConnMaxIdleTime))
+	return db, nil
+}
+
+// NewClient подключается к базе данных, проверяет, что схема актуальна, и
+// возвращает клиент, записывающий время и спаны запросов. Метрики запросов и
+// пула соединений регистрируются в reg.
+//
+// Схема обновляется командой migrate up. С cfg.AutoMigrate схема вместо этого
+// создаётся по ent при запуске — только для разработки.
+func NewClient(cfg config.DB, reg prometheus.Registerer) *ent.Client {
+	db, err := Open(cfg)
 	if err != nil {
 		log.Fatalf("failed opening connection to postgres: %v", err)
 	}
-	// Выполняем автоматическую миграцию схемы
-	if err := client.Schema.Create(context.Background()); err != nil {
+	drv := entsql.OpenDB(dialect.Postgres, db)
+	if cfg.AutoMigrate {
+		log.Println("db.auto_migrate is enabled, creating schema from ent (development only)")
+		autoMigrate(drv, db)
+	} else {
+		checkMigrations(db)
+	}
+
+	reg.MustRegister(queryDuration, collectors.NewDBStatsCollector(db, "orders"))
+	return ent.NewClient(ent.Driver(instrumentedDriver{drv}))
+}
+
+// autoMigrate создаёт схему по ent без версионных миграций.
+func autoMigrate(drv *entsql.Driver, db *sql.DB) {
+	// Данные, которые автоматическая миграция не умеет преобразовать сама
+	if err := migrateData(context.Background(), db); err != nil {
+		log.Fatalf("failed migrating data: %v", err)
+	}
+	if err := ent.NewClient(ent.Driver(drv)).Schema.Create(context.Background()); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
-	return client
+	if err := migrateIndexes(context.Background(), db); err != nil {
+		log.Fatalf("failed creating indexes: %v", err)
+	}
+}
+
+// checkMigrations останавливает запуск, если в базе применены не все миграции:
+// сервис не должен работать со схемой, которую не ожидает.
+func checkMigrations(db *sql.DB) {
+	m, err := NewMigrator(db)
+	if err != nil {
+		log.Fatalf("failed loading migrations: %v", err)
+	}
+	pending, err := m.Pending(context.Background())
+	if err != nil {
+		log.Fatalf("failed checking migrations: %v", err)
+	}
+	if len(pending) > 0 {
+		log.Fatalf("database schema is out of date: %d pending migration(s), run `migrate up` first", len(pending))
+	}
+}
+
+// Ping проверяет, что база данных отвечает на запросы.
+func Ping(ctx context.Context, client *ent.Client) error {
+	_, err := client.ExecContext(ctx, "SELECT 1")
+	return err
 }
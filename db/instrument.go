@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	stdsql "database/sql"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "db_query_duration_seconds",
+	Help:    "Время выполнения запросов к базе данных.",
+	Buckets: prometheus.DefBuckets,
+}, []string{"operation", "status"})
+
+var tracer = otel.Tracer("github.com/Ostap00034/course-work-backend-order-service/db")
+
+func observe(operation string, start time.Time, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	queryDuration.WithLabelValues(operation, status).Observe(time.Since(start).Seconds())
+}
+
+// begin начинает спан запроса query и возвращает функцию, которая завершает его
+// и записывает время выполнения в метрики.
+func begin(ctx context.Context, operation, query string) func(error) {
+	start := time.Now()
+	_, span := tracer.Start(ctx, "db."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(query),
+			attribute.String("db.operation.kind", operation),
+		),
+	)
+	return func(err error) {
+		observe(operation, start, err)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// instrumentedDriver записывает время и спаны запросов ent. Методы ExecContext
+// и QueryContext нужны для функции sql/execquery сгенерированного клиента.
+type instrumentedDriver struct {
+	*entsql.Driver
+}
+
+func (d instrumentedDriver) Exec(ctx context.Context, query string, args, v any) (err error) {
+	defer func(end func(error)) { end(err) }(begin(ctx, "exec", query))
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d instrumentedDriver) Query(ctx context.Context, query string, args, v any) (err error) {
+	defer func(end func(error)) { end(err) }(begin(ctx, "query", query))
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d instrumentedDriver) ExecContext(ctx context.Context, query string, args ...any) (res stdsql.Result, err error) {
+	defer func(end func(error)) { end(err) }(begin(ctx, "exec", query))
+	return d.Driver.ExecContext(ctx, query, args...)
+}
+
+func (d instrumentedDriver) QueryContext(ctx context.Context, query string, args ...any) (rows *stdsql.Rows, err error) {
+	defer func(end func(error)) { end(err) }(begin(ctx, "query", query))
+	return d.Driver.QueryContext(ctx, query, args...)
+}
+
+func (d instrumentedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newInstrumentedTx(ctx, tx), nil
+}
+
+func (d instrumentedDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.Driver.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newInstrumentedTx(ctx, tx), nil
+}
+
+// instrumentedTx записывает запросы внутри транзакции и длительность самой
+// транзакции; спаны запросов вложены в спан транзакции.
+type instrumentedTx struct {
+	dialect.Tx
+	ctx   context.Context
+	span  trace.Span
+	start time.Time
+}
+
+func newInstrumentedTx(ctx context.Context, tx dialect.Tx) *instrumentedTx {
+	ctx, span := tracer.Start(ctx, "db.tx",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL),
+	)
+	return &instrumentedTx{Tx: tx, ctx: ctx, span: span, start: time.Now()}
+}
+
+// queryContext переносит спан транзакции в контекст запроса ctx.
+func (tx *instrumentedTx) queryContext(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, tx.span)
+}
+
+func (tx *instrumentedTx) Exec(ctx context.Context, query string, args, v any) (err error) {
+	defer func(end func(error)) { end(err) }(begin(tx.queryContext(ctx), "exec", query))
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *instrumentedTx) Query(ctx context.Context, query string, args, v any) (err error) {
+	defer func(end func(error)) { end(err) }(begin(tx.queryContext(ctx), "query", query))
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+func (tx *instrumentedTx) ExecContext(ctx context.Context, query string, args ...any) (res stdsql.Result, err error) {
+	defer func(end func(error)) { end(err) }(begin(tx.queryContext(ctx), "exec", query))
+	return tx.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	}).ExecContext(ctx, query, args...)
+}
+
+func (tx *instrumentedTx) QueryContext(ctx context.Context, query string, args ...any) (rows *stdsql.Rows, err error) {
+	defer func(end func(error)) { end(err) }(begin(tx.queryContext(ctx), "query", query))
+	return tx.Tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	}).QueryContext(ctx, query, args...)
+}
+
+func (tx *instrumentedTx) Commit() (err error) {
+	defer func() { tx.finish("tx_commit", err) }()
+	return tx.Tx.Commit()
+}
+
+func (tx *instrumentedTx) Rollback() (err error) {
+	defer func() { tx.finish("tx_rollback", err) }()
+	return tx.Tx.Rollback()
+}
+
+func (tx *instrumentedTx) finish(operation string, err error) {
+	observe(operation, tx.start, err)
+	tx.span.SetAttributes(attribute.String("db.tx.outcome", operation))
+	if err != nil {
+		tx.span.RecordError(err)
+		tx.span.SetStatus(codes.Error, err.Error())
+	}
+	tx.span.End()
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"log"
+)
+
+// convertCoordinates переводит строковые longitude/latitude в double precision.
+// Значения, которые не удаётся разобрать как число, заменяются на 0.
+const convertCoordinates = `
+ALTER TABLE orders
+	ALTER COLUMN longitude TYPE double precision USING (
+		CASE WHEN trim(longitude) ~ '^-?[0-9]+([.,][0-9]+)?$'
+		THEN replace(trim(longitude), ',', '.')::double precision ELSE 0 END
+	),
+	ALTER COLUMN latitude TYPE double precision USING (
+		CASE WHEN trim(latitude) ~ '^-?[0-9]+([.,][0-9]+)?$'
+		THEN replace(trim(latitude), ',', '.')::double precision ELSE 0 END
+	)`
+
+// createSearchIndex создаёт GIN-индекс для полнотекстового поиска заказов.
+// Выражение должно совпадать с searchDocumentSQL в internal/search.go.
+const createSearchIndex = `
+CREATE INDEX IF NOT EXISTS orders_search_idx ON orders
+	USING GIN (to_tsvector('russian', title || ' ' || description || ' ' || address))`
+
+// migrateData и migrateIndexes дополняют Schema.Create при db.auto_migrate.
+// Версионные миграции выполняют те же шаги в db/migrations.
+
+// migrateData выполняет преобразования данных, которые должны пройти до Schema.Create.
+// Все шаги идемпотентны и пропускаются, если уже были применены.
+func migrateData(ctx context.Context, db *sql.DB) error {
+	var dataType string
+	err := db.QueryRowContext(ctx, `
+		SELECT data_type FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'orders' AND column_name = 'longitude'`,
+	).Scan(&dataType)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if dataType == "double precision" {
+		return nil
+	}
+
+	log.Println("converting order coordinates to double precision")
+	_, err = db.ExecContext(ctx, convertCoordinates)
+	return err
+}
+
+// migrateIndexes создаёт индексы, которые нельзя описать в схеме ent.
+// Выполняется после Schema.Create, когда таблицы уже существуют.
+func migrateIndexes(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, createSearchIndex)
+	return err
+}
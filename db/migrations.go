@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+)
+
+// migrationFiles — версионные миграции в формате golang-migrate
+// (<версия>_<имя>.up.sql и .down.sql) с контрольной суммой atlas.sum.
+// Новые миграции генерируются по схеме ent: make migration name=<имя>.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// createMigrationsTable создаёт таблицу применённых миграций.
+const createMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version bigint PRIMARY KEY,
+	name text NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`
+
+// migrationLockID — ключ advisory-блокировки, под которой применяются миграции,
+// чтобы несколько экземпляров сервиса не выполняли их одновременно.
+const migrationLockID = 5_306_441_117
+
+// Migration — версионная миграция схемы.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus — миграция и время её применения (нулевое, если не применена).
+type MigrationStatus struct {
+	Migration
+	AppliedAt time.Time
+}
+
+func (s MigrationStatus) Applied() bool {
+	return !s.AppliedAt.IsZero()
+}
+
+// Migrations возвращает встроенные миграции по возрастанию версии.
+// Файлы предварительно сверяются с atlas.sum, чтобы изменённая задним числом
+// миграция не применилась незаметно.
+func Migrations() ([]Migration, error) {
+	sub, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	dir := migrationDir{&sqltool.GolangMigrateDir{FS: sub}}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("migration directory does not match %s: %w", migrate.HashFileName, err)
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(files))
+	for _, f := range files {
+		version, err := strconv.ParseInt(f.Version(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: invalid version %q", f.Name(), f.Version())
+		}
+		down, err := fs.ReadFile(sub, strings.TrimSuffix(f.Name(), ".up.sql")+".down.sql")
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", f.Name(), err)
+		}
+		migrations = append(migrations, Migration{
+			Version: version,
+			Name:    f.Desc(),
+			Up:      string(f.Bytes()),
+			Down:    string(down),
+		})
+	}
+	return migrations, nil
+}
+
+// migrationDir — встроенный каталог миграций. Контрольная сумма считается, как у
+// каталога на диске, по всем .sql-файлам вместе с .down.sql, чтобы совпадать с
+// atlas.sum, который записывает генератор миграций.
+type migrationDir struct {
+	*sqltool.GolangMigrateDir
+}
+
+func (d migrationDir) Checksum() (migrate.HashFile, error) {
+	names, err := fs.Glob(d, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	files := make([]migrate.File, len(names))
+	for i, n := range names {
+		b, err := fs.ReadFile(d, n)
+		if err != nil {
+			return nil, err
+		}
+		files[i] = migrate.NewLocalFile(n, b)
+	}
+	return migrate.NewHashFile(files)
+}
+
+// Migrator применяет и откатывает версионные миграции. Применённые версии
+// хранятся в таблице schema_migrations; каждая миграция выполняется в своей
+// транзакции под advisory-блокировкой.
+type Migrator struct {
+	// DryRun — только вывести SQL в Out, ничего не выполняя.
+	DryRun bool
+	Out    io.Writer
+
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{Out: os.Stdout, db: db, migrations: migrations}, nil
+}
+
+// Status возвращает все встроенные миграции с отметкой о применении.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := appliedMigrations(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]MigrationStatus, len(m.migrations))
+	for i, mg := range m.migrations {
+		out[i] = MigrationStatus{Migration: mg, AppliedAt: applied[mg.Version]}
+	}
+	return out, nil
+}
+
+// Pending возвращает миграции, которые ещё не применены.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, s := range statuses {
+		if !s.Applied() {
+			pending = append(pending, s.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// Up применяет все неприменённые миграции и возвращает их число.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	var n int
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, mg := range m.migrations {
+			if _, ok := applied[mg.Version]; ok {
+				continue
+			}
+			if err := m.run(ctx, conn, mg, "up", mg.Up,
+				`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, mg.Version, mg.Name); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// Down откатывает последние steps применённых миграций и возвращает их число.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	var n int
+	err := m.locked(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && n < steps; i-- {
+			mg := m.migrations[i]
+			if _, ok := applied[mg.Version]; !ok {
+				continue
+			}
+			if err := m.run(ctx, conn, mg, "down", mg.Down,
+				`DELETE FROM schema_migrations WHERE version = $1`, mg.Version); err != nil {
+				return err
+			}
+			n++
+		}
+		return nil
+	})
+	return n, err
+}
+
+// locked выполняет fn на отдельном соединении под advisory-блокировкой.
+// В режиме DryRun блокировка не берётся и таблица миграций не создаётся.
+func (m *Migrator) locked(ctx context.Context, fn func(*sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if m.DryRun {
+		return fn(conn)
+	}
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			log.Printf("failed to release migration lock: %v", err)
+		}
+	}()
+	if _, err := conn.ExecContext(ctx, createMigrationsTable); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+// run выполняет script миграции mg и запрос record, обновляющий
+// schema_migrations, в одной транзакции.
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, mg Migration, direction, script, record string, args ...any) error {
+	if m.DryRun {
+		_, err := fmt.Fprintf(m.Out, "-- %s: %d_%s\n%s\n\n", direction, mg.Version, mg.Name, strings.TrimSpace(script))
+		return err
+	}
+
+	log.Printf("migrating %s: %d_%s", direction, mg.Version, mg.Name)
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d_%s (%s): %w", mg.Version, mg.Name, direction, err)
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d_%s (%s): %w", mg.Version, mg.Name, direction, err)
+	}
+	return tx.Commit()
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// appliedMigrations возвращает время применения каждой применённой версии.
+// Если таблицы schema_migrations ещё нет, ни одна миграция не применена.
+func appliedMigrations(ctx context.Context, q queryer) (map[int64]time.Time, error) {
+	var exists bool
+	if err := q.QueryRowContext(ctx, `SELECT to_regclass('schema_migrations') IS NOT NULL`).Scan(&exists); err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]time.Time)
+	if !exists {
+		return applied, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
@@ -0,0 +1,10 @@
+-- reverse: create "outbox_events" table
+DROP TABLE "outbox_events";
+-- reverse: create "order_status_changes" table
+DROP TABLE "order_status_changes";
+-- reverse: create "offers" table
+DROP TABLE "offers";
+-- reverse: create "idempotency_keys" table
+DROP TABLE "idempotency_keys";
+-- reverse: create "orders" table
+DROP TABLE "orders";
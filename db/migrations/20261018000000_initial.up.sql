@@ -0,0 +1,77 @@
+-- Исходная схема сервиса заказов. До версионных миграций схема создавалась
+-- автоматически при запуске, поэтому все объекты создаются с IF NOT EXISTS:
+-- базы, уже созданные автоматической миграцией, принимают эту миграцию.
+
+-- create "orders" table
+CREATE TABLE IF NOT EXISTS "orders" ("id" uuid NOT NULL, "title" character varying NOT NULL, "description" character varying NOT NULL, "price" real NOT NULL DEFAULT 0, "address" character varying NOT NULL, "longitude" double precision NOT NULL, "latitude" double precision NOT NULL, "category_id" uuid NOT NULL, "client_id" uuid NOT NULL, "master_id" uuid NULL, "status" character varying NOT NULL DEFAULT 'active', "version" bigint NOT NULL DEFAULT 1, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Базы, созданные автоматической миграцией до появления версий и мягкого
+-- удаления, уже содержат "orders" без этих столбцов: CREATE TABLE для них
+-- пропускается, поэтому столбцы добавляются отдельно.
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "deleted_at" timestamptz NULL;
+-- Старые базы хранили координаты строками. Значения, которые не удаётся
+-- разобрать как число или которые выходят за пределы (долгота ±180, широта ±90,
+-- как в validateCoordinates), не переносятся: такие заказы снимаются с
+-- публикации мягким удалением, их идентификаторы выводятся в WARNING, а
+-- координаты заменяются на 0 только чтобы выполнить NOT NULL.
+DO $$
+DECLARE
+	invalid text;
+BEGIN
+	IF EXISTS (
+		SELECT 1 FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = 'orders'
+			AND column_name = 'longitude' AND data_type <> 'double precision'
+	) THEN
+		ALTER TABLE orders
+			ALTER COLUMN longitude DROP NOT NULL,
+			ALTER COLUMN latitude DROP NOT NULL;
+		ALTER TABLE orders
+			ALTER COLUMN longitude TYPE double precision USING (
+				CASE WHEN trim(longitude) ~ '^-?[0-9]+([.,][0-9]+)?$'
+				THEN replace(trim(longitude), ',', '.')::double precision END
+			),
+			ALTER COLUMN latitude TYPE double precision USING (
+				CASE WHEN trim(latitude) ~ '^-?[0-9]+([.,][0-9]+)?$'
+				THEN replace(trim(latitude), ',', '.')::double precision END
+			);
+
+		SELECT string_agg(id::text, ', ' ORDER BY id) INTO invalid
+		FROM orders
+		WHERE longitude IS NULL OR latitude IS NULL
+			OR abs(longitude) > 180 OR abs(latitude) > 90;
+		IF invalid IS NOT NULL THEN
+			RAISE WARNING 'orders with invalid legacy coordinates were soft-deleted: %', invalid;
+			UPDATE orders
+			SET longitude = 0, latitude = 0, deleted_at = coalesce(deleted_at, now())
+			WHERE longitude IS NULL OR latitude IS NULL
+				OR abs(longitude) > 180 OR abs(latitude) > 90;
+		END IF;
+
+		ALTER TABLE orders
+			ALTER COLUMN longitude SET NOT NULL,
+			ALTER COLUMN latitude SET NOT NULL;
+	END IF;
+END $$;
+-- create index "order_latitude_longitude" to table: "orders"
+CREATE INDEX IF NOT EXISTS "order_latitude_longitude" ON "orders" ("latitude", "longitude");
+-- create index "order_deleted_at" to table: "orders"
+CREATE INDEX IF NOT EXISTS "order_deleted_at" ON "orders" ("deleted_at");
+-- create index "orders_search_idx" to table: "orders"; выражение должно совпадать с searchDocumentSQL в internal/search.go
+CREATE INDEX IF NOT EXISTS "orders_search_idx" ON "orders" USING GIN (to_tsvector('russian', title || ' ' || description || ' ' || address));
+-- create "idempotency_keys" table
+CREATE TABLE IF NOT EXISTS "idempotency_keys" ("id" uuid NOT NULL, "key" character varying NOT NULL, "client_id" uuid NOT NULL, "fingerprint" character varying NOT NULL, "created_at" timestamptz NOT NULL, "order_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "idempotency_keys_orders_idempotency_keys" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "idempotencykey_client_id_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX IF NOT EXISTS "idempotencykey_client_id_key" ON "idempotency_keys" ("client_id", "key");
+-- create "offers" table
+CREATE TABLE IF NOT EXISTS "offers" ("id" uuid NOT NULL, "master_id" uuid NOT NULL, "price" real NOT NULL, "comment" character varying NOT NULL DEFAULT '', "estimated_at" timestamptz NULL, "status" character varying NOT NULL DEFAULT 'pending', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "order_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "offers_orders_offers" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "offer_order_id_master_id" to table: "offers"
+CREATE UNIQUE INDEX IF NOT EXISTS "offer_order_id_master_id" ON "offers" ("order_id", "master_id");
+-- create "order_status_changes" table
+CREATE TABLE IF NOT EXISTS "order_status_changes" ("id" uuid NOT NULL, "from_status" character varying NULL, "to_status" character varying NOT NULL, "actor_id" uuid NULL, "reason" character varying NOT NULL DEFAULT '', "created_at" timestamptz NOT NULL, "order_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "order_status_changes_orders_status_changes" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "orderstatuschange_order_id_created_at" to table: "order_status_changes"
+CREATE INDEX IF NOT EXISTS "orderstatuschange_order_id_created_at" ON "order_status_changes" ("order_id", "created_at");
+-- create "outbox_events" table
+CREATE TABLE IF NOT EXISTS "outbox_events" ("id" uuid NOT NULL, "order_id" uuid NOT NULL, "type" character varying NOT NULL, "payload" jsonb NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "last_error" character varying NOT NULL DEFAULT '', "next_attempt_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, "published_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "outboxevent_status_next_attempt_at" to table: "outbox_events"
+CREATE INDEX IF NOT EXISTS "outboxevent_status_next_attempt_at" ON "outbox_events" ("status", "next_attempt_at");
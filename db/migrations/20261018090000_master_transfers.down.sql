@@ -0,0 +1,2 @@
+-- reverse: create "master_transfers" table
+DROP TABLE "master_transfers";
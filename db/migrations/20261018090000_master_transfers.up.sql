@@ -0,0 +1,6 @@
+-- create "master_transfers" table
+CREATE TABLE "master_transfers" ("id" uuid NOT NULL, "from_master_id" uuid NOT NULL, "to_master_id" uuid NOT NULL, "reason" character varying NOT NULL DEFAULT '', "status" character varying NOT NULL DEFAULT 'pending', "requested_by" uuid NOT NULL, "decided_by" uuid NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "order_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "master_transfers_orders_transfers" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "mastertransfer_order_id" to table: "master_transfers"
+CREATE UNIQUE INDEX "mastertransfer_order_id" ON "master_transfers" ("order_id") WHERE (status = 'pending'::text);
+-- create index "mastertransfer_to_master_id_status" to table: "master_transfers"
+CREATE INDEX "mastertransfer_to_master_id_status" ON "master_transfers" ("to_master_id", "status");
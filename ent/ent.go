@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +78,12 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			order.Table: order.ValidColumn,
+			idempotencykey.Table:    idempotencykey.ValidColumn,
+			mastertransfer.Table:    mastertransfer.ValidColumn,
+			offer.Table:             offer.ValidColumn,
+			order.Table:             order.ValidColumn,
+			orderstatuschange.Table: orderstatuschange.ValidColumn,
+			outboxevent.Table:       outboxevent.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey is the model entity for the IdempotencyKey schema.
+type IdempotencyKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Ключ, переданный клиентом
+	Key string `json:"key,omitempty"`
+	// ID клиента, создавшего заказ
+	ClientID uuid.UUID `json:"client_id,omitempty"`
+	// Отпечаток тела запроса
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// ID созданного заказа
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IdempotencyKeyQuery when eager-loading is set.
+	Edges        IdempotencyKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IdempotencyKeyEdges holds the relations/edges for other nodes in the graph.
+type IdempotencyKeyEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdempotencyKeyEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotencyKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldKey, idempotencykey.FieldFingerprint:
+			values[i] = new(sql.NullString)
+		case idempotencykey.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case idempotencykey.FieldID, idempotencykey.FieldClientID, idempotencykey.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotencyKey fields.
+func (ik *IdempotencyKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotencykey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ik.ID = *value
+			}
+		case idempotencykey.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ik.Key = value.String
+			}
+		case idempotencykey.FieldClientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value != nil {
+				ik.ClientID = *value
+			}
+		case idempotencykey.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				ik.Fingerprint = value.String
+			}
+		case idempotencykey.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				ik.OrderID = *value
+			}
+		case idempotencykey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ik.CreatedAt = value.Time
+			}
+		default:
+			ik.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdempotencyKey.
+// This includes values selected through modifiers, order, etc.
+func (ik *IdempotencyKey) Value(name string) (ent.Value, error) {
+	return ik.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the IdempotencyKey entity.
+func (ik *IdempotencyKey) QueryOrder() *OrderQuery {
+	return NewIdempotencyKeyClient(ik.config).QueryOrder(ik)
+}
+
+// Update returns a builder for updating this IdempotencyKey.
+// Note that you need to call IdempotencyKey.Unwrap() before calling this method if this IdempotencyKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ik *IdempotencyKey) Update() *IdempotencyKeyUpdateOne {
+	return NewIdempotencyKeyClient(ik.config).UpdateOne(ik)
+}
+
+// Unwrap unwraps the IdempotencyKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ik *IdempotencyKey) Unwrap() *IdempotencyKey {
+	_tx, ok := ik.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdempotencyKey is not a transactional entity")
+	}
+	ik.config.driver = _tx.drv
+	return ik
+}
+
+// String implements the fmt.Stringer.
+func (ik *IdempotencyKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotencyKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ik.ID))
+	builder.WriteString("key=")
+	builder.WriteString(ik.Key)
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", ik.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(ik.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", ik.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ik.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotencyKeys is a parsable slice of IdempotencyKey.
+type IdempotencyKeys []*IdempotencyKey
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyCreate is the builder for creating a IdempotencyKey entity.
+type IdempotencyKeyCreate struct {
+	config
+	mutation *IdempotencyKeyMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (ikc *IdempotencyKeyCreate) SetKey(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetKey(s)
+	return ikc
+}
+
+// SetClientID sets the "client_id" field.
+func (ikc *IdempotencyKeyCreate) SetClientID(u uuid.UUID) *IdempotencyKeyCreate {
+	ikc.mutation.SetClientID(u)
+	return ikc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ikc *IdempotencyKeyCreate) SetFingerprint(s string) *IdempotencyKeyCreate {
+	ikc.mutation.SetFingerprint(s)
+	return ikc
+}
+
+// SetOrderID sets the "order_id" field.
+func (ikc *IdempotencyKeyCreate) SetOrderID(u uuid.UUID) *IdempotencyKeyCreate {
+	ikc.mutation.SetOrderID(u)
+	return ikc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ikc *IdempotencyKeyCreate) SetCreatedAt(t time.Time) *IdempotencyKeyCreate {
+	ikc.mutation.SetCreatedAt(t)
+	return ikc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableCreatedAt(t *time.Time) *IdempotencyKeyCreate {
+	if t != nil {
+		ikc.SetCreatedAt(*t)
+	}
+	return ikc
+}
+
+// SetID sets the "id" field.
+func (ikc *IdempotencyKeyCreate) SetID(u uuid.UUID) *IdempotencyKeyCreate {
+	ikc.mutation.SetID(u)
+	return ikc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ikc *IdempotencyKeyCreate) SetNillableID(u *uuid.UUID) *IdempotencyKeyCreate {
+	if u != nil {
+		ikc.SetID(*u)
+	}
+	return ikc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (ikc *IdempotencyKeyCreate) SetOrder(o *Order) *IdempotencyKeyCreate {
+	return ikc.SetOrderID(o.ID)
+}
+
+// Mutation returns the IdempotencyKeyMutation object of the builder.
+func (ikc *IdempotencyKeyCreate) Mutation() *IdempotencyKeyMutation {
+	return ikc.mutation
+}
+
+// Save creates the IdempotencyKey in the database.
+func (ikc *IdempotencyKeyCreate) Save(ctx context.Context) (*IdempotencyKey, error) {
+	ikc.defaults()
+	return withHooks(ctx, ikc.sqlSave, ikc.mutation, ikc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ikc *IdempotencyKeyCreate) SaveX(ctx context.Context) *IdempotencyKey {
+	v, err := ikc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikc *IdempotencyKeyCreate) Exec(ctx context.Context) error {
+	_, err := ikc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikc *IdempotencyKeyCreate) ExecX(ctx context.Context) {
+	if err := ikc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ikc *IdempotencyKeyCreate) defaults() {
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		v := idempotencykey.DefaultCreatedAt()
+		ikc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ikc.mutation.ID(); !ok {
+		v := idempotencykey.DefaultID()
+		ikc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ikc *IdempotencyKeyCreate) check() error {
+	if _, ok := ikc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "IdempotencyKey.key"`)}
+	}
+	if v, ok := ikc.mutation.Key(); ok {
+		if err := idempotencykey.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "IdempotencyKey.key": %w`, err)}
+		}
+	}
+	if _, ok := ikc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "IdempotencyKey.client_id"`)}
+	}
+	if _, ok := ikc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "IdempotencyKey.fingerprint"`)}
+	}
+	if _, ok := ikc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "IdempotencyKey.order_id"`)}
+	}
+	if _, ok := ikc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdempotencyKey.created_at"`)}
+	}
+	if len(ikc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "IdempotencyKey.order"`)}
+	}
+	return nil
+}
+
+func (ikc *IdempotencyKeyCreate) sqlSave(ctx context.Context) (*IdempotencyKey, error) {
+	if err := ikc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ikc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ikc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ikc.mutation.id = &_node.ID
+	ikc.mutation.done = true
+	return _node, nil
+}
+
+func (ikc *IdempotencyKeyCreate) createSpec() (*IdempotencyKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotencyKey{config: ikc.config}
+		_spec = sqlgraph.NewCreateSpec(idempotencykey.Table, sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID))
+	)
+	if id, ok := ikc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ikc.mutation.Key(); ok {
+		_spec.SetField(idempotencykey.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := ikc.mutation.ClientID(); ok {
+		_spec.SetField(idempotencykey.FieldClientID, field.TypeUUID, value)
+		_node.ClientID = value
+	}
+	if value, ok := ikc.mutation.Fingerprint(); ok {
+		_spec.SetField(idempotencykey.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := ikc.mutation.CreatedAt(); ok {
+		_spec.SetField(idempotencykey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ikc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotencykey.OrderTable,
+			Columns: []string{idempotencykey.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdempotencyKeyCreateBulk is the builder for creating many IdempotencyKey entities in bulk.
+type IdempotencyKeyCreateBulk struct {
+	config
+	err      error
+	builders []*IdempotencyKeyCreate
+}
+
+// Save creates the IdempotencyKey entities in the database.
+func (ikcb *IdempotencyKeyCreateBulk) Save(ctx context.Context) ([]*IdempotencyKey, error) {
+	if ikcb.err != nil {
+		return nil, ikcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ikcb.builders))
+	nodes := make([]*IdempotencyKey, len(ikcb.builders))
+	mutators := make([]Mutator, len(ikcb.builders))
+	for i := range ikcb.builders {
+		func(i int, root context.Context) {
+			builder := ikcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotencyKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ikcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ikcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ikcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) SaveX(ctx context.Context) []*IdempotencyKey {
+	v, err := ikcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ikcb *IdempotencyKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := ikcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ikcb *IdempotencyKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := ikcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+)
+
+// MasterTransfer is the model entity for the MasterTransfer schema.
+type MasterTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID заказа
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// ID исполнителя, передающего заказ
+	FromMasterID uuid.UUID `json:"from_master_id,omitempty"`
+	// ID исполнителя, которому передаётся заказ
+	ToMasterID uuid.UUID `json:"to_master_id,omitempty"`
+	// Причина передачи
+	Reason string `json:"reason,omitempty"`
+	// Status holds the value of the "status" field.
+	Status mastertransfer.Status `json:"status,omitempty"`
+	// ID пользователя, запросившего передачу
+	RequestedBy uuid.UUID `json:"requested_by,omitempty"`
+	// ID пользователя, принявшего или отклонившего передачу
+	DecidedBy uuid.UUID `json:"decided_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MasterTransferQuery when eager-loading is set.
+	Edges        MasterTransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MasterTransferEdges holds the relations/edges for other nodes in the graph.
+type MasterTransferEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MasterTransferEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MasterTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mastertransfer.FieldReason, mastertransfer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case mastertransfer.FieldCreatedAt, mastertransfer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case mastertransfer.FieldID, mastertransfer.FieldOrderID, mastertransfer.FieldFromMasterID, mastertransfer.FieldToMasterID, mastertransfer.FieldRequestedBy, mastertransfer.FieldDecidedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MasterTransfer fields.
+func (mt *MasterTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mastertransfer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mt.ID = *value
+			}
+		case mastertransfer.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				mt.OrderID = *value
+			}
+		case mastertransfer.FieldFromMasterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field from_master_id", values[i])
+			} else if value != nil {
+				mt.FromMasterID = *value
+			}
+		case mastertransfer.FieldToMasterID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field to_master_id", values[i])
+			} else if value != nil {
+				mt.ToMasterID = *value
+			}
+		case mastertransfer.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				mt.Reason = value.String
+			}
+		case mastertransfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				mt.Status = mastertransfer.Status(value.String)
+			}
+		case mastertransfer.FieldRequestedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value != nil {
+				mt.RequestedBy = *value
+			}
+		case mastertransfer.FieldDecidedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field decided_by", values[i])
+			} else if value != nil {
+				mt.DecidedBy = *value
+			}
+		case mastertransfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mt.CreatedAt = value.Time
+			}
+		case mastertransfer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				mt.UpdatedAt = value.Time
+			}
+		default:
+			mt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MasterTransfer.
+// This includes values selected through modifiers, order, etc.
+func (mt *MasterTransfer) Value(name string) (ent.Value, error) {
+	return mt.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the MasterTransfer entity.
+func (mt *MasterTransfer) QueryOrder() *OrderQuery {
+	return NewMasterTransferClient(mt.config).QueryOrder(mt)
+}
+
+// Update returns a builder for updating this MasterTransfer.
+// Note that you need to call MasterTransfer.Unwrap() before calling this method if this MasterTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mt *MasterTransfer) Update() *MasterTransferUpdateOne {
+	return NewMasterTransferClient(mt.config).UpdateOne(mt)
+}
+
+// Unwrap unwraps the MasterTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mt *MasterTransfer) Unwrap() *MasterTransfer {
+	_tx, ok := mt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MasterTransfer is not a transactional entity")
+	}
+	mt.config.driver = _tx.drv
+	return mt
+}
+
+// String implements the fmt.Stringer.
+func (mt *MasterTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("MasterTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mt.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("from_master_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.FromMasterID))
+	builder.WriteString(", ")
+	builder.WriteString("to_master_id=")
+	builder.WriteString(fmt.Sprintf("%v", mt.ToMasterID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(mt.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", mt.Status))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(fmt.Sprintf("%v", mt.RequestedBy))
+	builder.WriteString(", ")
+	builder.WriteString("decided_by=")
+	builder.WriteString(fmt.Sprintf("%v", mt.DecidedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(mt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MasterTransfers is a parsable slice of MasterTransfer.
+type MasterTransfers []*MasterTransfer
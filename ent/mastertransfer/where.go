@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mastertransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldOrderID, v))
+}
+
+// FromMasterID applies equality check predicate on the "from_master_id" field. It's identical to FromMasterIDEQ.
+func FromMasterID(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldFromMasterID, v))
+}
+
+// ToMasterID applies equality check predicate on the "to_master_id" field. It's identical to ToMasterIDEQ.
+func ToMasterID(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldToMasterID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldReason, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// DecidedBy applies equality check predicate on the "decided_by" field. It's identical to DecidedByEQ.
+func DecidedBy(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// FromMasterIDEQ applies the EQ predicate on the "from_master_id" field.
+func FromMasterIDEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldFromMasterID, v))
+}
+
+// FromMasterIDNEQ applies the NEQ predicate on the "from_master_id" field.
+func FromMasterIDNEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldFromMasterID, v))
+}
+
+// FromMasterIDIn applies the In predicate on the "from_master_id" field.
+func FromMasterIDIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldFromMasterID, vs...))
+}
+
+// FromMasterIDNotIn applies the NotIn predicate on the "from_master_id" field.
+func FromMasterIDNotIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldFromMasterID, vs...))
+}
+
+// FromMasterIDGT applies the GT predicate on the "from_master_id" field.
+func FromMasterIDGT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldFromMasterID, v))
+}
+
+// FromMasterIDGTE applies the GTE predicate on the "from_master_id" field.
+func FromMasterIDGTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldFromMasterID, v))
+}
+
+// FromMasterIDLT applies the LT predicate on the "from_master_id" field.
+func FromMasterIDLT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldFromMasterID, v))
+}
+
+// FromMasterIDLTE applies the LTE predicate on the "from_master_id" field.
+func FromMasterIDLTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldFromMasterID, v))
+}
+
+// ToMasterIDEQ applies the EQ predicate on the "to_master_id" field.
+func ToMasterIDEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldToMasterID, v))
+}
+
+// ToMasterIDNEQ applies the NEQ predicate on the "to_master_id" field.
+func ToMasterIDNEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldToMasterID, v))
+}
+
+// ToMasterIDIn applies the In predicate on the "to_master_id" field.
+func ToMasterIDIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldToMasterID, vs...))
+}
+
+// ToMasterIDNotIn applies the NotIn predicate on the "to_master_id" field.
+func ToMasterIDNotIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldToMasterID, vs...))
+}
+
+// ToMasterIDGT applies the GT predicate on the "to_master_id" field.
+func ToMasterIDGT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldToMasterID, v))
+}
+
+// ToMasterIDGTE applies the GTE predicate on the "to_master_id" field.
+func ToMasterIDGTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldToMasterID, v))
+}
+
+// ToMasterIDLT applies the LT predicate on the "to_master_id" field.
+func ToMasterIDLT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldToMasterID, v))
+}
+
+// ToMasterIDLTE applies the LTE predicate on the "to_master_id" field.
+func ToMasterIDLTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldToMasterID, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldContainsFold(FieldReason, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// DecidedByEQ applies the EQ predicate on the "decided_by" field.
+func DecidedByEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldDecidedBy, v))
+}
+
+// DecidedByNEQ applies the NEQ predicate on the "decided_by" field.
+func DecidedByNEQ(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldDecidedBy, v))
+}
+
+// DecidedByIn applies the In predicate on the "decided_by" field.
+func DecidedByIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByNotIn applies the NotIn predicate on the "decided_by" field.
+func DecidedByNotIn(vs ...uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldDecidedBy, vs...))
+}
+
+// DecidedByGT applies the GT predicate on the "decided_by" field.
+func DecidedByGT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldDecidedBy, v))
+}
+
+// DecidedByGTE applies the GTE predicate on the "decided_by" field.
+func DecidedByGTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldDecidedBy, v))
+}
+
+// DecidedByLT applies the LT predicate on the "decided_by" field.
+func DecidedByLT(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldDecidedBy, v))
+}
+
+// DecidedByLTE applies the LTE predicate on the "decided_by" field.
+func DecidedByLTE(v uuid.UUID) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldDecidedBy, v))
+}
+
+// DecidedByIsNil applies the IsNil predicate on the "decided_by" field.
+func DecidedByIsNil() predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIsNull(FieldDecidedBy))
+}
+
+// DecidedByNotNil applies the NotNil predicate on the "decided_by" field.
+func DecidedByNotNil() predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotNull(FieldDecidedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.MasterTransfer {
+	return predicate.MasterTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.MasterTransfer {
+	return predicate.MasterTransfer(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MasterTransfer) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MasterTransfer) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MasterTransfer) predicate.MasterTransfer {
+	return predicate.MasterTransfer(sql.NotPredicates(p))
+}
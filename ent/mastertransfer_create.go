@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+)
+
+// MasterTransferCreate is the builder for creating a MasterTransfer entity.
+type MasterTransferCreate struct {
+	config
+	mutation *MasterTransferMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (mtc *MasterTransferCreate) SetOrderID(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetOrderID(u)
+	return mtc
+}
+
+// SetFromMasterID sets the "from_master_id" field.
+func (mtc *MasterTransferCreate) SetFromMasterID(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetFromMasterID(u)
+	return mtc
+}
+
+// SetToMasterID sets the "to_master_id" field.
+func (mtc *MasterTransferCreate) SetToMasterID(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetToMasterID(u)
+	return mtc
+}
+
+// SetReason sets the "reason" field.
+func (mtc *MasterTransferCreate) SetReason(s string) *MasterTransferCreate {
+	mtc.mutation.SetReason(s)
+	return mtc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableReason(s *string) *MasterTransferCreate {
+	if s != nil {
+		mtc.SetReason(*s)
+	}
+	return mtc
+}
+
+// SetStatus sets the "status" field.
+func (mtc *MasterTransferCreate) SetStatus(m mastertransfer.Status) *MasterTransferCreate {
+	mtc.mutation.SetStatus(m)
+	return mtc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableStatus(m *mastertransfer.Status) *MasterTransferCreate {
+	if m != nil {
+		mtc.SetStatus(*m)
+	}
+	return mtc
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (mtc *MasterTransferCreate) SetRequestedBy(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetRequestedBy(u)
+	return mtc
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (mtc *MasterTransferCreate) SetDecidedBy(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetDecidedBy(u)
+	return mtc
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableDecidedBy(u *uuid.UUID) *MasterTransferCreate {
+	if u != nil {
+		mtc.SetDecidedBy(*u)
+	}
+	return mtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mtc *MasterTransferCreate) SetCreatedAt(t time.Time) *MasterTransferCreate {
+	mtc.mutation.SetCreatedAt(t)
+	return mtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableCreatedAt(t *time.Time) *MasterTransferCreate {
+	if t != nil {
+		mtc.SetCreatedAt(*t)
+	}
+	return mtc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtc *MasterTransferCreate) SetUpdatedAt(t time.Time) *MasterTransferCreate {
+	mtc.mutation.SetUpdatedAt(t)
+	return mtc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableUpdatedAt(t *time.Time) *MasterTransferCreate {
+	if t != nil {
+		mtc.SetUpdatedAt(*t)
+	}
+	return mtc
+}
+
+// SetID sets the "id" field.
+func (mtc *MasterTransferCreate) SetID(u uuid.UUID) *MasterTransferCreate {
+	mtc.mutation.SetID(u)
+	return mtc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mtc *MasterTransferCreate) SetNillableID(u *uuid.UUID) *MasterTransferCreate {
+	if u != nil {
+		mtc.SetID(*u)
+	}
+	return mtc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (mtc *MasterTransferCreate) SetOrder(o *Order) *MasterTransferCreate {
+	return mtc.SetOrderID(o.ID)
+}
+
+// Mutation returns the MasterTransferMutation object of the builder.
+func (mtc *MasterTransferCreate) Mutation() *MasterTransferMutation {
+	return mtc.mutation
+}
+
+// Save creates the MasterTransfer in the database.
+func (mtc *MasterTransferCreate) Save(ctx context.Context) (*MasterTransfer, error) {
+	mtc.defaults()
+	return withHooks(ctx, mtc.sqlSave, mtc.mutation, mtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mtc *MasterTransferCreate) SaveX(ctx context.Context) *MasterTransfer {
+	v, err := mtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtc *MasterTransferCreate) Exec(ctx context.Context) error {
+	_, err := mtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtc *MasterTransferCreate) ExecX(ctx context.Context) {
+	if err := mtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtc *MasterTransferCreate) defaults() {
+	if _, ok := mtc.mutation.Reason(); !ok {
+		v := mastertransfer.DefaultReason
+		mtc.mutation.SetReason(v)
+	}
+	if _, ok := mtc.mutation.Status(); !ok {
+		v := mastertransfer.DefaultStatus
+		mtc.mutation.SetStatus(v)
+	}
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		v := mastertransfer.DefaultCreatedAt()
+		mtc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mtc.mutation.UpdatedAt(); !ok {
+		v := mastertransfer.DefaultUpdatedAt()
+		mtc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mtc.mutation.ID(); !ok {
+		v := mastertransfer.DefaultID()
+		mtc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtc *MasterTransferCreate) check() error {
+	if _, ok := mtc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "MasterTransfer.order_id"`)}
+	}
+	if _, ok := mtc.mutation.FromMasterID(); !ok {
+		return &ValidationError{Name: "from_master_id", err: errors.New(`ent: missing required field "MasterTransfer.from_master_id"`)}
+	}
+	if _, ok := mtc.mutation.ToMasterID(); !ok {
+		return &ValidationError{Name: "to_master_id", err: errors.New(`ent: missing required field "MasterTransfer.to_master_id"`)}
+	}
+	if _, ok := mtc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "MasterTransfer.reason"`)}
+	}
+	if _, ok := mtc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MasterTransfer.status"`)}
+	}
+	if v, ok := mtc.mutation.Status(); ok {
+		if err := mastertransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MasterTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := mtc.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "MasterTransfer.requested_by"`)}
+	}
+	if _, ok := mtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MasterTransfer.created_at"`)}
+	}
+	if _, ok := mtc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MasterTransfer.updated_at"`)}
+	}
+	if len(mtc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "MasterTransfer.order"`)}
+	}
+	return nil
+}
+
+func (mtc *MasterTransferCreate) sqlSave(ctx context.Context) (*MasterTransfer, error) {
+	if err := mtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mtc.mutation.id = &_node.ID
+	mtc.mutation.done = true
+	return _node, nil
+}
+
+func (mtc *MasterTransferCreate) createSpec() (*MasterTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MasterTransfer{config: mtc.config}
+		_spec = sqlgraph.NewCreateSpec(mastertransfer.Table, sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID))
+	)
+	if id, ok := mtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mtc.mutation.FromMasterID(); ok {
+		_spec.SetField(mastertransfer.FieldFromMasterID, field.TypeUUID, value)
+		_node.FromMasterID = value
+	}
+	if value, ok := mtc.mutation.ToMasterID(); ok {
+		_spec.SetField(mastertransfer.FieldToMasterID, field.TypeUUID, value)
+		_node.ToMasterID = value
+	}
+	if value, ok := mtc.mutation.Reason(); ok {
+		_spec.SetField(mastertransfer.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := mtc.mutation.Status(); ok {
+		_spec.SetField(mastertransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mtc.mutation.RequestedBy(); ok {
+		_spec.SetField(mastertransfer.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := mtc.mutation.DecidedBy(); ok {
+		_spec.SetField(mastertransfer.FieldDecidedBy, field.TypeUUID, value)
+		_node.DecidedBy = value
+	}
+	if value, ok := mtc.mutation.CreatedAt(); ok {
+		_spec.SetField(mastertransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mtc.mutation.UpdatedAt(); ok {
+		_spec.SetField(mastertransfer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := mtc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mastertransfer.OrderTable,
+			Columns: []string{mastertransfer.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MasterTransferCreateBulk is the builder for creating many MasterTransfer entities in bulk.
+type MasterTransferCreateBulk struct {
+	config
+	err      error
+	builders []*MasterTransferCreate
+}
+
+// Save creates the MasterTransfer entities in the database.
+func (mtcb *MasterTransferCreateBulk) Save(ctx context.Context) ([]*MasterTransfer, error) {
+	if mtcb.err != nil {
+		return nil, mtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mtcb.builders))
+	nodes := make([]*MasterTransfer, len(mtcb.builders))
+	mutators := make([]Mutator, len(mtcb.builders))
+	for i := range mtcb.builders {
+		func(i int, root context.Context) {
+			builder := mtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MasterTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtcb *MasterTransferCreateBulk) SaveX(ctx context.Context) []*MasterTransfer {
+	v, err := mtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mtcb *MasterTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := mtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtcb *MasterTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := mtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+// MasterTransferDelete is the builder for deleting a MasterTransfer entity.
+type MasterTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *MasterTransferMutation
+}
+
+// Where appends a list predicates to the MasterTransferDelete builder.
+func (mtd *MasterTransferDelete) Where(ps ...predicate.MasterTransfer) *MasterTransferDelete {
+	mtd.mutation.Where(ps...)
+	return mtd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mtd *MasterTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mtd.sqlExec, mtd.mutation, mtd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtd *MasterTransferDelete) ExecX(ctx context.Context) int {
+	n, err := mtd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mtd *MasterTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(mastertransfer.Table, sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID))
+	if ps := mtd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mtd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mtd.mutation.done = true
+	return affected, err
+}
+
+// MasterTransferDeleteOne is the builder for deleting a single MasterTransfer entity.
+type MasterTransferDeleteOne struct {
+	mtd *MasterTransferDelete
+}
+
+// Where appends a list predicates to the MasterTransferDelete builder.
+func (mtdo *MasterTransferDeleteOne) Where(ps ...predicate.MasterTransfer) *MasterTransferDeleteOne {
+	mtdo.mtd.mutation.Where(ps...)
+	return mtdo
+}
+
+// Exec executes the deletion query.
+func (mtdo *MasterTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := mtdo.mtd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{mastertransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtdo *MasterTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := mtdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MasterTransferQuery is the builder for querying MasterTransfer entities.
+type MasterTransferQuery struct {
+	config
+	ctx        *QueryContext
+	order      []mastertransfer.OrderOption
+	inters     []Interceptor
+	predicates []predicate.MasterTransfer
+	withOrder  *OrderQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MasterTransferQuery builder.
+func (mtq *MasterTransferQuery) Where(ps ...predicate.MasterTransfer) *MasterTransferQuery {
+	mtq.predicates = append(mtq.predicates, ps...)
+	return mtq
+}
+
+// Limit the number of records to be returned by this query.
+func (mtq *MasterTransferQuery) Limit(limit int) *MasterTransferQuery {
+	mtq.ctx.Limit = &limit
+	return mtq
+}
+
+// Offset to start from.
+func (mtq *MasterTransferQuery) Offset(offset int) *MasterTransferQuery {
+	mtq.ctx.Offset = &offset
+	return mtq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mtq *MasterTransferQuery) Unique(unique bool) *MasterTransferQuery {
+	mtq.ctx.Unique = &unique
+	return mtq
+}
+
+// Order specifies how the records should be ordered.
+func (mtq *MasterTransferQuery) Order(o ...mastertransfer.OrderOption) *MasterTransferQuery {
+	mtq.order = append(mtq.order, o...)
+	return mtq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (mtq *MasterTransferQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: mtq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mtq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mtq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mastertransfer.Table, mastertransfer.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mastertransfer.OrderTable, mastertransfer.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mtq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first MasterTransfer entity from the query.
+// Returns a *NotFoundError when no MasterTransfer was found.
+func (mtq *MasterTransferQuery) First(ctx context.Context) (*MasterTransfer, error) {
+	nodes, err := mtq.Limit(1).All(setContextOp(ctx, mtq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{mastertransfer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mtq *MasterTransferQuery) FirstX(ctx context.Context) *MasterTransfer {
+	node, err := mtq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MasterTransfer ID from the query.
+// Returns a *NotFoundError when no MasterTransfer ID was found.
+func (mtq *MasterTransferQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mtq.Limit(1).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{mastertransfer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mtq *MasterTransferQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mtq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MasterTransfer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one MasterTransfer entity is found.
+// Returns a *NotFoundError when no MasterTransfer entities are found.
+func (mtq *MasterTransferQuery) Only(ctx context.Context) (*MasterTransfer, error) {
+	nodes, err := mtq.Limit(2).All(setContextOp(ctx, mtq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{mastertransfer.Label}
+	default:
+		return nil, &NotSingularError{mastertransfer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mtq *MasterTransferQuery) OnlyX(ctx context.Context) *MasterTransfer {
+	node, err := mtq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MasterTransfer ID in the query.
+// Returns a *NotSingularError when more than one MasterTransfer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mtq *MasterTransferQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mtq.Limit(2).IDs(setContextOp(ctx, mtq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{mastertransfer.Label}
+	default:
+		err = &NotSingularError{mastertransfer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mtq *MasterTransferQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mtq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MasterTransfers.
+func (mtq *MasterTransferQuery) All(ctx context.Context) ([]*MasterTransfer, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryAll)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*MasterTransfer, *MasterTransferQuery]()
+	return withInterceptors[[]*MasterTransfer](ctx, mtq, qr, mtq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mtq *MasterTransferQuery) AllX(ctx context.Context) []*MasterTransfer {
+	nodes, err := mtq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MasterTransfer IDs.
+func (mtq *MasterTransferQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mtq.ctx.Unique == nil && mtq.path != nil {
+		mtq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryIDs)
+	if err = mtq.Select(mastertransfer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mtq *MasterTransferQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mtq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mtq *MasterTransferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryCount)
+	if err := mtq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mtq, querierCount[*MasterTransferQuery](), mtq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mtq *MasterTransferQuery) CountX(ctx context.Context) int {
+	count, err := mtq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mtq *MasterTransferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mtq.ctx, ent.OpQueryExist)
+	switch _, err := mtq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mtq *MasterTransferQuery) ExistX(ctx context.Context) bool {
+	exist, err := mtq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MasterTransferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mtq *MasterTransferQuery) Clone() *MasterTransferQuery {
+	if mtq == nil {
+		return nil
+	}
+	return &MasterTransferQuery{
+		config:     mtq.config,
+		ctx:        mtq.ctx.Clone(),
+		order:      append([]mastertransfer.OrderOption{}, mtq.order...),
+		inters:     append([]Interceptor{}, mtq.inters...),
+		predicates: append([]predicate.MasterTransfer{}, mtq.predicates...),
+		withOrder:  mtq.withOrder.Clone(),
+		// clone intermediate query.
+		sql:  mtq.sql.Clone(),
+		path: mtq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (mtq *MasterTransferQuery) WithOrder(opts ...func(*OrderQuery)) *MasterTransferQuery {
+	query := (&OrderClient{config: mtq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mtq.withOrder = query
+	return mtq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MasterTransfer.Query().
+//		GroupBy(mastertransfer.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mtq *MasterTransferQuery) GroupBy(field string, fields ...string) *MasterTransferGroupBy {
+	mtq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &MasterTransferGroupBy{build: mtq}
+	grbuild.flds = &mtq.ctx.Fields
+	grbuild.label = mastertransfer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//	}
+//
+//	client.MasterTransfer.Query().
+//		Select(mastertransfer.FieldOrderID).
+//		Scan(ctx, &v)
+func (mtq *MasterTransferQuery) Select(fields ...string) *MasterTransferSelect {
+	mtq.ctx.Fields = append(mtq.ctx.Fields, fields...)
+	sbuild := &MasterTransferSelect{MasterTransferQuery: mtq}
+	sbuild.label = mastertransfer.Label
+	sbuild.flds, sbuild.scan = &mtq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a MasterTransferSelect configured with the given aggregations.
+func (mtq *MasterTransferQuery) Aggregate(fns ...AggregateFunc) *MasterTransferSelect {
+	return mtq.Select().Aggregate(fns...)
+}
+
+func (mtq *MasterTransferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mtq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mtq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mtq.ctx.Fields {
+		if !mastertransfer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mtq.path != nil {
+		prev, err := mtq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mtq.sql = prev
+	}
+	return nil
+}
+
+func (mtq *MasterTransferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*MasterTransfer, error) {
+	var (
+		nodes       = []*MasterTransfer{}
+		_spec       = mtq.querySpec()
+		loadedTypes = [1]bool{
+			mtq.withOrder != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*MasterTransfer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &MasterTransfer{config: mtq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mtq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mtq.withOrder; query != nil {
+		if err := mtq.loadOrder(ctx, query, nodes, nil,
+			func(n *MasterTransfer, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mtq *MasterTransferQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*MasterTransfer, init func(*MasterTransfer), assign func(*MasterTransfer, *Order)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*MasterTransfer)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mtq *MasterTransferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mtq.querySpec()
+	if len(mtq.modifiers) > 0 {
+		_spec.Modifiers = mtq.modifiers
+	}
+	_spec.Node.Columns = mtq.ctx.Fields
+	if len(mtq.ctx.Fields) > 0 {
+		_spec.Unique = mtq.ctx.Unique != nil && *mtq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mtq.driver, _spec)
+}
+
+func (mtq *MasterTransferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(mastertransfer.Table, mastertransfer.Columns, sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID))
+	_spec.From = mtq.sql
+	if unique := mtq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mtq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mtq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mastertransfer.FieldID)
+		for i := range fields {
+			if fields[i] != mastertransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mtq.withOrder != nil {
+			_spec.Node.AddColumnOnce(mastertransfer.FieldOrderID)
+		}
+	}
+	if ps := mtq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mtq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mtq *MasterTransferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mtq.driver.Dialect())
+	t1 := builder.Table(mastertransfer.Table)
+	columns := mtq.ctx.Fields
+	if len(columns) == 0 {
+		columns = mastertransfer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mtq.sql != nil {
+		selector = mtq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mtq.ctx.Unique != nil && *mtq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mtq.modifiers {
+		m(selector)
+	}
+	for _, p := range mtq.predicates {
+		p(selector)
+	}
+	for _, p := range mtq.order {
+		p(selector)
+	}
+	if offset := mtq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mtq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mtq *MasterTransferQuery) ForUpdate(opts ...sql.LockOption) *MasterTransferQuery {
+	if mtq.driver.Dialect() == dialect.Postgres {
+		mtq.Unique(false)
+	}
+	mtq.modifiers = append(mtq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mtq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mtq *MasterTransferQuery) ForShare(opts ...sql.LockOption) *MasterTransferQuery {
+	if mtq.driver.Dialect() == dialect.Postgres {
+		mtq.Unique(false)
+	}
+	mtq.modifiers = append(mtq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mtq
+}
+
+// MasterTransferGroupBy is the group-by builder for MasterTransfer entities.
+type MasterTransferGroupBy struct {
+	selector
+	build *MasterTransferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mtgb *MasterTransferGroupBy) Aggregate(fns ...AggregateFunc) *MasterTransferGroupBy {
+	mtgb.fns = append(mtgb.fns, fns...)
+	return mtgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mtgb *MasterTransferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mtgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mtgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MasterTransferQuery, *MasterTransferGroupBy](ctx, mtgb.build, mtgb, mtgb.build.inters, v)
+}
+
+func (mtgb *MasterTransferGroupBy) sqlScan(ctx context.Context, root *MasterTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mtgb.fns))
+	for _, fn := range mtgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mtgb.flds)+len(mtgb.fns))
+		for _, f := range *mtgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mtgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mtgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// MasterTransferSelect is the builder for selecting fields of MasterTransfer entities.
+type MasterTransferSelect struct {
+	*MasterTransferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mts *MasterTransferSelect) Aggregate(fns ...AggregateFunc) *MasterTransferSelect {
+	mts.fns = append(mts.fns, fns...)
+	return mts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mts *MasterTransferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mts.ctx, ent.OpQuerySelect)
+	if err := mts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*MasterTransferQuery, *MasterTransferSelect](ctx, mts.MasterTransferQuery, mts, mts.inters, v)
+}
+
+func (mts *MasterTransferSelect) sqlScan(ctx context.Context, root *MasterTransferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mts.fns))
+	for _, fn := range mts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// MasterTransferUpdate is the builder for updating MasterTransfer entities.
+type MasterTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MasterTransferMutation
+}
+
+// Where appends a list predicates to the MasterTransferUpdate builder.
+func (mtu *MasterTransferUpdate) Where(ps ...predicate.MasterTransfer) *MasterTransferUpdate {
+	mtu.mutation.Where(ps...)
+	return mtu
+}
+
+// SetOrderID sets the "order_id" field.
+func (mtu *MasterTransferUpdate) SetOrderID(u uuid.UUID) *MasterTransferUpdate {
+	mtu.mutation.SetOrderID(u)
+	return mtu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (mtu *MasterTransferUpdate) SetNillableOrderID(u *uuid.UUID) *MasterTransferUpdate {
+	if u != nil {
+		mtu.SetOrderID(*u)
+	}
+	return mtu
+}
+
+// SetStatus sets the "status" field.
+func (mtu *MasterTransferUpdate) SetStatus(m mastertransfer.Status) *MasterTransferUpdate {
+	mtu.mutation.SetStatus(m)
+	return mtu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mtu *MasterTransferUpdate) SetNillableStatus(m *mastertransfer.Status) *MasterTransferUpdate {
+	if m != nil {
+		mtu.SetStatus(*m)
+	}
+	return mtu
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (mtu *MasterTransferUpdate) SetDecidedBy(u uuid.UUID) *MasterTransferUpdate {
+	mtu.mutation.SetDecidedBy(u)
+	return mtu
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (mtu *MasterTransferUpdate) SetNillableDecidedBy(u *uuid.UUID) *MasterTransferUpdate {
+	if u != nil {
+		mtu.SetDecidedBy(*u)
+	}
+	return mtu
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (mtu *MasterTransferUpdate) ClearDecidedBy() *MasterTransferUpdate {
+	mtu.mutation.ClearDecidedBy()
+	return mtu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtu *MasterTransferUpdate) SetUpdatedAt(t time.Time) *MasterTransferUpdate {
+	mtu.mutation.SetUpdatedAt(t)
+	return mtu
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (mtu *MasterTransferUpdate) SetOrder(o *Order) *MasterTransferUpdate {
+	return mtu.SetOrderID(o.ID)
+}
+
+// Mutation returns the MasterTransferMutation object of the builder.
+func (mtu *MasterTransferUpdate) Mutation() *MasterTransferMutation {
+	return mtu.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (mtu *MasterTransferUpdate) ClearOrder() *MasterTransferUpdate {
+	mtu.mutation.ClearOrder()
+	return mtu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mtu *MasterTransferUpdate) Save(ctx context.Context) (int, error) {
+	mtu.defaults()
+	return withHooks(ctx, mtu.sqlSave, mtu.mutation, mtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtu *MasterTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := mtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mtu *MasterTransferUpdate) Exec(ctx context.Context) error {
+	_, err := mtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtu *MasterTransferUpdate) ExecX(ctx context.Context) {
+	if err := mtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtu *MasterTransferUpdate) defaults() {
+	if _, ok := mtu.mutation.UpdatedAt(); !ok {
+		v := mastertransfer.UpdateDefaultUpdatedAt()
+		mtu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtu *MasterTransferUpdate) check() error {
+	if v, ok := mtu.mutation.Status(); ok {
+		if err := mastertransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MasterTransfer.status": %w`, err)}
+		}
+	}
+	if mtu.mutation.OrderCleared() && len(mtu.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MasterTransfer.order"`)
+	}
+	return nil
+}
+
+func (mtu *MasterTransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mtu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mastertransfer.Table, mastertransfer.Columns, sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID))
+	if ps := mtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtu.mutation.Status(); ok {
+		_spec.SetField(mastertransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mtu.mutation.DecidedBy(); ok {
+		_spec.SetField(mastertransfer.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if mtu.mutation.DecidedByCleared() {
+		_spec.ClearField(mastertransfer.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := mtu.mutation.UpdatedAt(); ok {
+		_spec.SetField(mastertransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mtu.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mastertransfer.OrderTable,
+			Columns: []string{mastertransfer.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtu.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mastertransfer.OrderTable,
+			Columns: []string{mastertransfer.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mastertransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mtu.mutation.done = true
+	return n, nil
+}
+
+// MasterTransferUpdateOne is the builder for updating a single MasterTransfer entity.
+type MasterTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MasterTransferMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (mtuo *MasterTransferUpdateOne) SetOrderID(u uuid.UUID) *MasterTransferUpdateOne {
+	mtuo.mutation.SetOrderID(u)
+	return mtuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (mtuo *MasterTransferUpdateOne) SetNillableOrderID(u *uuid.UUID) *MasterTransferUpdateOne {
+	if u != nil {
+		mtuo.SetOrderID(*u)
+	}
+	return mtuo
+}
+
+// SetStatus sets the "status" field.
+func (mtuo *MasterTransferUpdateOne) SetStatus(m mastertransfer.Status) *MasterTransferUpdateOne {
+	mtuo.mutation.SetStatus(m)
+	return mtuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mtuo *MasterTransferUpdateOne) SetNillableStatus(m *mastertransfer.Status) *MasterTransferUpdateOne {
+	if m != nil {
+		mtuo.SetStatus(*m)
+	}
+	return mtuo
+}
+
+// SetDecidedBy sets the "decided_by" field.
+func (mtuo *MasterTransferUpdateOne) SetDecidedBy(u uuid.UUID) *MasterTransferUpdateOne {
+	mtuo.mutation.SetDecidedBy(u)
+	return mtuo
+}
+
+// SetNillableDecidedBy sets the "decided_by" field if the given value is not nil.
+func (mtuo *MasterTransferUpdateOne) SetNillableDecidedBy(u *uuid.UUID) *MasterTransferUpdateOne {
+	if u != nil {
+		mtuo.SetDecidedBy(*u)
+	}
+	return mtuo
+}
+
+// ClearDecidedBy clears the value of the "decided_by" field.
+func (mtuo *MasterTransferUpdateOne) ClearDecidedBy() *MasterTransferUpdateOne {
+	mtuo.mutation.ClearDecidedBy()
+	return mtuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mtuo *MasterTransferUpdateOne) SetUpdatedAt(t time.Time) *MasterTransferUpdateOne {
+	mtuo.mutation.SetUpdatedAt(t)
+	return mtuo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (mtuo *MasterTransferUpdateOne) SetOrder(o *Order) *MasterTransferUpdateOne {
+	return mtuo.SetOrderID(o.ID)
+}
+
+// Mutation returns the MasterTransferMutation object of the builder.
+func (mtuo *MasterTransferUpdateOne) Mutation() *MasterTransferMutation {
+	return mtuo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (mtuo *MasterTransferUpdateOne) ClearOrder() *MasterTransferUpdateOne {
+	mtuo.mutation.ClearOrder()
+	return mtuo
+}
+
+// Where appends a list predicates to the MasterTransferUpdate builder.
+func (mtuo *MasterTransferUpdateOne) Where(ps ...predicate.MasterTransfer) *MasterTransferUpdateOne {
+	mtuo.mutation.Where(ps...)
+	return mtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mtuo *MasterTransferUpdateOne) Select(field string, fields ...string) *MasterTransferUpdateOne {
+	mtuo.fields = append([]string{field}, fields...)
+	return mtuo
+}
+
+// Save executes the query and returns the updated MasterTransfer entity.
+func (mtuo *MasterTransferUpdateOne) Save(ctx context.Context) (*MasterTransfer, error) {
+	mtuo.defaults()
+	return withHooks(ctx, mtuo.sqlSave, mtuo.mutation, mtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mtuo *MasterTransferUpdateOne) SaveX(ctx context.Context) *MasterTransfer {
+	node, err := mtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mtuo *MasterTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := mtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mtuo *MasterTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := mtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mtuo *MasterTransferUpdateOne) defaults() {
+	if _, ok := mtuo.mutation.UpdatedAt(); !ok {
+		v := mastertransfer.UpdateDefaultUpdatedAt()
+		mtuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mtuo *MasterTransferUpdateOne) check() error {
+	if v, ok := mtuo.mutation.Status(); ok {
+		if err := mastertransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MasterTransfer.status": %w`, err)}
+		}
+	}
+	if mtuo.mutation.OrderCleared() && len(mtuo.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MasterTransfer.order"`)
+	}
+	return nil
+}
+
+func (mtuo *MasterTransferUpdateOne) sqlSave(ctx context.Context) (_node *MasterTransfer, err error) {
+	if err := mtuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mastertransfer.Table, mastertransfer.Columns, sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID))
+	id, ok := mtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MasterTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mastertransfer.FieldID)
+		for _, f := range fields {
+			if !mastertransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mastertransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mtuo.mutation.Status(); ok {
+		_spec.SetField(mastertransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mtuo.mutation.DecidedBy(); ok {
+		_spec.SetField(mastertransfer.FieldDecidedBy, field.TypeUUID, value)
+	}
+	if mtuo.mutation.DecidedByCleared() {
+		_spec.ClearField(mastertransfer.FieldDecidedBy, field.TypeUUID)
+	}
+	if value, ok := mtuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(mastertransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if mtuo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mastertransfer.OrderTable,
+			Columns: []string{mastertransfer.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mtuo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mastertransfer.OrderTable,
+			Columns: []string{mastertransfer.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MasterTransfer{config: mtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mastertransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mtuo.mutation.done = true
+	return _node, nil
+}
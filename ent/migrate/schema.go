@@ -3,11 +3,117 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "key", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeUUID},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_keys_orders_idempotency_keys",
+				Columns:    []*schema.Column{IdempotencyKeysColumns[5]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_client_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2], IdempotencyKeysColumns[1]},
+			},
+		},
+	}
+	// MasterTransfersColumns holds the columns for the "master_transfers" table.
+	MasterTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "from_master_id", Type: field.TypeUUID},
+		{Name: "to_master_id", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "cancelled"}, Default: "pending"},
+		{Name: "requested_by", Type: field.TypeUUID},
+		{Name: "decided_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// MasterTransfersTable holds the schema information for the "master_transfers" table.
+	MasterTransfersTable = &schema.Table{
+		Name:       "master_transfers",
+		Columns:    MasterTransfersColumns,
+		PrimaryKey: []*schema.Column{MasterTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "master_transfers_orders_transfers",
+				Columns:    []*schema.Column{MasterTransfersColumns[9]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mastertransfer_order_id",
+				Unique:  true,
+				Columns: []*schema.Column{MasterTransfersColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'pending'",
+				},
+			},
+			{
+				Name:    "mastertransfer_to_master_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{MasterTransfersColumns[2], MasterTransfersColumns[4]},
+			},
+		},
+	}
+	// OffersColumns holds the columns for the "offers" table.
+	OffersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "master_id", Type: field.TypeUUID},
+		{Name: "price", Type: field.TypeFloat32},
+		{Name: "comment", Type: field.TypeString, Default: ""},
+		{Name: "estimated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "rejected"}, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// OffersTable holds the schema information for the "offers" table.
+	OffersTable = &schema.Table{
+		Name:       "offers",
+		Columns:    OffersColumns,
+		PrimaryKey: []*schema.Column{OffersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "offers_orders_offers",
+				Columns:    []*schema.Column{OffersColumns[8]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "offer_order_id_master_id",
+				Unique:  true,
+				Columns: []*schema.Column{OffersColumns[8], OffersColumns[1]},
+			},
+		},
+	}
 	// OrdersColumns holds the columns for the "orders" table.
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -15,26 +121,106 @@ var (
 		{Name: "description", Type: field.TypeString},
 		{Name: "price", Type: field.TypeFloat32, Default: 0},
 		{Name: "address", Type: field.TypeString},
-		{Name: "longitude", Type: field.TypeString},
-		{Name: "latitude", Type: field.TypeString},
+		{Name: "longitude", Type: field.TypeFloat64},
+		{Name: "latitude", Type: field.TypeFloat64},
 		{Name: "category_id", Type: field.TypeUUID},
 		{Name: "client_id", Type: field.TypeUUID},
 		{Name: "master_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"active", "in_progress", "cancel", "done"}, Default: "active"},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
 		Name:       "orders",
 		Columns:    OrdersColumns,
 		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_latitude_longitude",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[6], OrdersColumns[5]},
+			},
+			{
+				Name:    "order_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[14]},
+			},
+		},
+	}
+	// OrderStatusChangesColumns holds the columns for the "order_status_changes" table.
+	OrderStatusChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"active", "in_progress", "cancel", "done"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"active", "in_progress", "cancel", "done"}},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeUUID},
+	}
+	// OrderStatusChangesTable holds the schema information for the "order_status_changes" table.
+	OrderStatusChangesTable = &schema.Table{
+		Name:       "order_status_changes",
+		Columns:    OrderStatusChangesColumns,
+		PrimaryKey: []*schema.Column{OrderStatusChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_status_changes_orders_status_changes",
+				Columns:    []*schema.Column{OrderStatusChangesColumns[6]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "orderstatuschange_order_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrderStatusChangesColumns[6], OrderStatusChangesColumns[5]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "order_id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"order_created", "order_updated", "order_assigned", "order_status_changed", "order_deleted", "order_restored", "order_unassigned", "master_transfer_requested", "master_transfer_declined"}},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "published", "dead"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[7]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		IdempotencyKeysTable,
+		MasterTransfersTable,
+		OffersTable,
 		OrdersTable,
+		OrderStatusChangesTable,
+		OutboxEventsTable,
 	}
 )
 
 func init() {
+	IdempotencyKeysTable.ForeignKeys[0].RefTable = OrdersTable
+	MasterTransfersTable.ForeignKeys[0].RefTable = OrdersTable
+	OffersTable.ForeignKeys[0].RefTable = OrdersTable
+	OrderStatusChangesTable.ForeignKeys[0].RefTable = OrdersTable
 }
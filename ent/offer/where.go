@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package offer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldID, id))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldOrderID, v))
+}
+
+// MasterID applies equality check predicate on the "master_id" field. It's identical to MasterIDEQ.
+func MasterID(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldMasterID, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldPrice, v))
+}
+
+// Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
+func Comment(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldComment, v))
+}
+
+// EstimatedAt applies equality check predicate on the "estimated_at" field. It's identical to EstimatedAtEQ.
+func EstimatedAt(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldEstimatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// MasterIDEQ applies the EQ predicate on the "master_id" field.
+func MasterIDEQ(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldMasterID, v))
+}
+
+// MasterIDNEQ applies the NEQ predicate on the "master_id" field.
+func MasterIDNEQ(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldMasterID, v))
+}
+
+// MasterIDIn applies the In predicate on the "master_id" field.
+func MasterIDIn(vs ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldMasterID, vs...))
+}
+
+// MasterIDNotIn applies the NotIn predicate on the "master_id" field.
+func MasterIDNotIn(vs ...uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldMasterID, vs...))
+}
+
+// MasterIDGT applies the GT predicate on the "master_id" field.
+func MasterIDGT(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldMasterID, v))
+}
+
+// MasterIDGTE applies the GTE predicate on the "master_id" field.
+func MasterIDGTE(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldMasterID, v))
+}
+
+// MasterIDLT applies the LT predicate on the "master_id" field.
+func MasterIDLT(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldMasterID, v))
+}
+
+// MasterIDLTE applies the LTE predicate on the "master_id" field.
+func MasterIDLTE(v uuid.UUID) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldMasterID, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float32) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float32) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float32) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldPrice, v))
+}
+
+// CommentEQ applies the EQ predicate on the "comment" field.
+func CommentEQ(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldComment, v))
+}
+
+// CommentNEQ applies the NEQ predicate on the "comment" field.
+func CommentNEQ(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldComment, v))
+}
+
+// CommentIn applies the In predicate on the "comment" field.
+func CommentIn(vs ...string) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldComment, vs...))
+}
+
+// CommentNotIn applies the NotIn predicate on the "comment" field.
+func CommentNotIn(vs ...string) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldComment, vs...))
+}
+
+// CommentGT applies the GT predicate on the "comment" field.
+func CommentGT(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldComment, v))
+}
+
+// CommentGTE applies the GTE predicate on the "comment" field.
+func CommentGTE(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldComment, v))
+}
+
+// CommentLT applies the LT predicate on the "comment" field.
+func CommentLT(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldComment, v))
+}
+
+// CommentLTE applies the LTE predicate on the "comment" field.
+func CommentLTE(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldComment, v))
+}
+
+// CommentContains applies the Contains predicate on the "comment" field.
+func CommentContains(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldContains(FieldComment, v))
+}
+
+// CommentHasPrefix applies the HasPrefix predicate on the "comment" field.
+func CommentHasPrefix(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldHasPrefix(FieldComment, v))
+}
+
+// CommentHasSuffix applies the HasSuffix predicate on the "comment" field.
+func CommentHasSuffix(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldHasSuffix(FieldComment, v))
+}
+
+// CommentEqualFold applies the EqualFold predicate on the "comment" field.
+func CommentEqualFold(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldEqualFold(FieldComment, v))
+}
+
+// CommentContainsFold applies the ContainsFold predicate on the "comment" field.
+func CommentContainsFold(v string) predicate.Offer {
+	return predicate.Offer(sql.FieldContainsFold(FieldComment, v))
+}
+
+// EstimatedAtEQ applies the EQ predicate on the "estimated_at" field.
+func EstimatedAtEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldEstimatedAt, v))
+}
+
+// EstimatedAtNEQ applies the NEQ predicate on the "estimated_at" field.
+func EstimatedAtNEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldEstimatedAt, v))
+}
+
+// EstimatedAtIn applies the In predicate on the "estimated_at" field.
+func EstimatedAtIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldEstimatedAt, vs...))
+}
+
+// EstimatedAtNotIn applies the NotIn predicate on the "estimated_at" field.
+func EstimatedAtNotIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldEstimatedAt, vs...))
+}
+
+// EstimatedAtGT applies the GT predicate on the "estimated_at" field.
+func EstimatedAtGT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldEstimatedAt, v))
+}
+
+// EstimatedAtGTE applies the GTE predicate on the "estimated_at" field.
+func EstimatedAtGTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldEstimatedAt, v))
+}
+
+// EstimatedAtLT applies the LT predicate on the "estimated_at" field.
+func EstimatedAtLT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldEstimatedAt, v))
+}
+
+// EstimatedAtLTE applies the LTE predicate on the "estimated_at" field.
+func EstimatedAtLTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldEstimatedAt, v))
+}
+
+// EstimatedAtIsNil applies the IsNil predicate on the "estimated_at" field.
+func EstimatedAtIsNil() predicate.Offer {
+	return predicate.Offer(sql.FieldIsNull(FieldEstimatedAt))
+}
+
+// EstimatedAtNotNil applies the NotNil predicate on the "estimated_at" field.
+func EstimatedAtNotNil() predicate.Offer {
+	return predicate.Offer(sql.FieldNotNull(FieldEstimatedAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Offer {
+	return predicate.Offer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.Offer {
+	return predicate.Offer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.Offer {
+	return predicate.Offer(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Offer) predicate.Offer {
+	return predicate.Offer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Offer) predicate.Offer {
+	return predicate.Offer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Offer) predicate.Offer {
+	return predicate.Offer(sql.NotPredicates(p))
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+// OfferDelete is the builder for deleting a Offer entity.
+type OfferDelete struct {
+	config
+	hooks    []Hook
+	mutation *OfferMutation
+}
+
+// Where appends a list predicates to the OfferDelete builder.
+func (od *OfferDelete) Where(ps ...predicate.Offer) *OfferDelete {
+	od.mutation.Where(ps...)
+	return od
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (od *OfferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, od.sqlExec, od.mutation, od.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (od *OfferDelete) ExecX(ctx context.Context) int {
+	n, err := od.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (od *OfferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(offer.Table, sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID))
+	if ps := od.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, od.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	od.mutation.done = true
+	return affected, err
+}
+
+// OfferDeleteOne is the builder for deleting a single Offer entity.
+type OfferDeleteOne struct {
+	od *OfferDelete
+}
+
+// Where appends a list predicates to the OfferDelete builder.
+func (odo *OfferDeleteOne) Where(ps ...predicate.Offer) *OfferDeleteOne {
+	odo.od.mutation.Where(ps...)
+	return odo
+}
+
+// Exec executes the deletion query.
+func (odo *OfferDeleteOne) Exec(ctx context.Context) error {
+	n, err := odo.od.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{offer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (odo *OfferDeleteOne) ExecX(ctx context.Context) {
+	if err := odo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OfferQuery is the builder for querying Offer entities.
+type OfferQuery struct {
+	config
+	ctx        *QueryContext
+	order      []offer.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Offer
+	withOrder  *OrderQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OfferQuery builder.
+func (oq *OfferQuery) Where(ps ...predicate.Offer) *OfferQuery {
+	oq.predicates = append(oq.predicates, ps...)
+	return oq
+}
+
+// Limit the number of records to be returned by this query.
+func (oq *OfferQuery) Limit(limit int) *OfferQuery {
+	oq.ctx.Limit = &limit
+	return oq
+}
+
+// Offset to start from.
+func (oq *OfferQuery) Offset(offset int) *OfferQuery {
+	oq.ctx.Offset = &offset
+	return oq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oq *OfferQuery) Unique(unique bool) *OfferQuery {
+	oq.ctx.Unique = &unique
+	return oq
+}
+
+// Order specifies how the records should be ordered.
+func (oq *OfferQuery) Order(o ...offer.OrderOption) *OfferQuery {
+	oq.order = append(oq.order, o...)
+	return oq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (oq *OfferQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(offer.Table, offer.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, offer.OrderTable, offer.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Offer entity from the query.
+// Returns a *NotFoundError when no Offer was found.
+func (oq *OfferQuery) First(ctx context.Context) (*Offer, error) {
+	nodes, err := oq.Limit(1).All(setContextOp(ctx, oq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{offer.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oq *OfferQuery) FirstX(ctx context.Context) *Offer {
+	node, err := oq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Offer ID from the query.
+// Returns a *NotFoundError when no Offer ID was found.
+func (oq *OfferQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oq.Limit(1).IDs(setContextOp(ctx, oq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{offer.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oq *OfferQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := oq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Offer entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Offer entity is found.
+// Returns a *NotFoundError when no Offer entities are found.
+func (oq *OfferQuery) Only(ctx context.Context) (*Offer, error) {
+	nodes, err := oq.Limit(2).All(setContextOp(ctx, oq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{offer.Label}
+	default:
+		return nil, &NotSingularError{offer.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oq *OfferQuery) OnlyX(ctx context.Context) *Offer {
+	node, err := oq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Offer ID in the query.
+// Returns a *NotSingularError when more than one Offer ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oq *OfferQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oq.Limit(2).IDs(setContextOp(ctx, oq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{offer.Label}
+	default:
+		err = &NotSingularError{offer.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oq *OfferQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := oq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Offers.
+func (oq *OfferQuery) All(ctx context.Context) ([]*Offer, error) {
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryAll)
+	if err := oq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Offer, *OfferQuery]()
+	return withInterceptors[[]*Offer](ctx, oq, qr, oq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oq *OfferQuery) AllX(ctx context.Context) []*Offer {
+	nodes, err := oq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Offer IDs.
+func (oq *OfferQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if oq.ctx.Unique == nil && oq.path != nil {
+		oq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryIDs)
+	if err = oq.Select(offer.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oq *OfferQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := oq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oq *OfferQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryCount)
+	if err := oq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oq, querierCount[*OfferQuery](), oq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oq *OfferQuery) CountX(ctx context.Context) int {
+	count, err := oq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oq *OfferQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oq.ctx, ent.OpQueryExist)
+	switch _, err := oq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oq *OfferQuery) ExistX(ctx context.Context) bool {
+	exist, err := oq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OfferQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oq *OfferQuery) Clone() *OfferQuery {
+	if oq == nil {
+		return nil
+	}
+	return &OfferQuery{
+		config:     oq.config,
+		ctx:        oq.ctx.Clone(),
+		order:      append([]offer.OrderOption{}, oq.order...),
+		inters:     append([]Interceptor{}, oq.inters...),
+		predicates: append([]predicate.Offer{}, oq.predicates...),
+		withOrder:  oq.withOrder.Clone(),
+		// clone intermediate query.
+		sql:  oq.sql.Clone(),
+		path: oq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OfferQuery) WithOrder(opts ...func(*OrderQuery)) *OfferQuery {
+	query := (&OrderClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withOrder = query
+	return oq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Offer.Query().
+//		GroupBy(offer.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oq *OfferQuery) GroupBy(field string, fields ...string) *OfferGroupBy {
+	oq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OfferGroupBy{build: oq}
+	grbuild.flds = &oq.ctx.Fields
+	grbuild.label = offer.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//	}
+//
+//	client.Offer.Query().
+//		Select(offer.FieldOrderID).
+//		Scan(ctx, &v)
+func (oq *OfferQuery) Select(fields ...string) *OfferSelect {
+	oq.ctx.Fields = append(oq.ctx.Fields, fields...)
+	sbuild := &OfferSelect{OfferQuery: oq}
+	sbuild.label = offer.Label
+	sbuild.flds, sbuild.scan = &oq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OfferSelect configured with the given aggregations.
+func (oq *OfferQuery) Aggregate(fns ...AggregateFunc) *OfferSelect {
+	return oq.Select().Aggregate(fns...)
+}
+
+func (oq *OfferQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oq.ctx.Fields {
+		if !offer.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oq.path != nil {
+		prev, err := oq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oq.sql = prev
+	}
+	return nil
+}
+
+func (oq *OfferQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Offer, error) {
+	var (
+		nodes       = []*Offer{}
+		_spec       = oq.querySpec()
+		loadedTypes = [1]bool{
+			oq.withOrder != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Offer).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Offer{config: oq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oq.withOrder; query != nil {
+		if err := oq.loadOrder(ctx, query, nodes, nil,
+			func(n *Offer, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oq *OfferQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*Offer, init func(*Offer), assign func(*Offer, *Order)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Offer)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oq *OfferQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oq.querySpec()
+	if len(oq.modifiers) > 0 {
+		_spec.Modifiers = oq.modifiers
+	}
+	_spec.Node.Columns = oq.ctx.Fields
+	if len(oq.ctx.Fields) > 0 {
+		_spec.Unique = oq.ctx.Unique != nil && *oq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oq.driver, _spec)
+}
+
+func (oq *OfferQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(offer.Table, offer.Columns, sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID))
+	_spec.From = oq.sql
+	if unique := oq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, offer.FieldID)
+		for i := range fields {
+			if fields[i] != offer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if oq.withOrder != nil {
+			_spec.Node.AddColumnOnce(offer.FieldOrderID)
+		}
+	}
+	if ps := oq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oq *OfferQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oq.driver.Dialect())
+	t1 := builder.Table(offer.Table)
+	columns := oq.ctx.Fields
+	if len(columns) == 0 {
+		columns = offer.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oq.sql != nil {
+		selector = oq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oq.ctx.Unique != nil && *oq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range oq.modifiers {
+		m(selector)
+	}
+	for _, p := range oq.predicates {
+		p(selector)
+	}
+	for _, p := range oq.order {
+		p(selector)
+	}
+	if offset := oq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oq *OfferQuery) ForUpdate(opts ...sql.LockOption) *OfferQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oq *OfferQuery) ForShare(opts ...sql.LockOption) *OfferQuery {
+	if oq.driver.Dialect() == dialect.Postgres {
+		oq.Unique(false)
+	}
+	oq.modifiers = append(oq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oq
+}
+
+// OfferGroupBy is the group-by builder for Offer entities.
+type OfferGroupBy struct {
+	selector
+	build *OfferQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ogb *OfferGroupBy) Aggregate(fns ...AggregateFunc) *OfferGroupBy {
+	ogb.fns = append(ogb.fns, fns...)
+	return ogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ogb *OfferGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ogb.build.ctx, ent.OpQueryGroupBy)
+	if err := ogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OfferQuery, *OfferGroupBy](ctx, ogb.build, ogb, ogb.build.inters, v)
+}
+
+func (ogb *OfferGroupBy) sqlScan(ctx context.Context, root *OfferQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ogb.fns))
+	for _, fn := range ogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ogb.flds)+len(ogb.fns))
+		for _, f := range *ogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OfferSelect is the builder for selecting fields of Offer entities.
+type OfferSelect struct {
+	*OfferQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (os *OfferSelect) Aggregate(fns ...AggregateFunc) *OfferSelect {
+	os.fns = append(os.fns, fns...)
+	return os
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (os *OfferSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, os.ctx, ent.OpQuerySelect)
+	if err := os.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OfferQuery, *OfferSelect](ctx, os.OfferQuery, os, os.inters, v)
+}
+
+func (os *OfferSelect) sqlScan(ctx context.Context, root *OfferQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(os.fns))
+	for _, fn := range os.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*os.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := os.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
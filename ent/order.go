@@ -20,16 +20,16 @@ type Order struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Название
 	Title string `json:"title,omitempty"`
-	// Описание заказа
+	// Описание заказа, может быть пустым
 	Description string `json:"description,omitempty"`
 	// Цена
 	Price float32 `json:"price,omitempty"`
 	// Адрес заказа
 	Address string `json:"address,omitempty"`
 	// Долгота
-	Longitude string `json:"longitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
 	// Широта
-	Latitude string `json:"latitude,omitempty"`
+	Latitude float64 `json:"latitude,omitempty"`
 	// ID категории
 	CategoryID uuid.UUID `json:"category_id,omitempty"`
 	// ID автора
@@ -38,23 +38,83 @@ type Order struct {
 	MasterID uuid.UUID `json:"master_id,omitempty"`
 	// Status holds the value of the "status" field.
 	Status order.Status `json:"status,omitempty"`
+	// Версия для оптимистичной блокировки, растёт при каждом изменении
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Время мягкого удаления
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderQuery when eager-loading is set.
+	Edges        OrderEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// OrderEdges holds the relations/edges for other nodes in the graph.
+type OrderEdges struct {
+	// История изменения статуса
+	StatusChanges []*OrderStatusChange `json:"status_changes,omitempty"`
+	// Предложения исполнителей
+	Offers []*Offer `json:"offers,omitempty"`
+	// Передачи заказа между исполнителями
+	Transfers []*MasterTransfer `json:"transfers,omitempty"`
+	// Ключи идемпотентности запросов на создание
+	IdempotencyKeys []*IdempotencyKey `json:"idempotency_keys,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// StatusChangesOrErr returns the StatusChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) StatusChangesOrErr() ([]*OrderStatusChange, error) {
+	if e.loadedTypes[0] {
+		return e.StatusChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "status_changes"}
+}
+
+// OffersOrErr returns the Offers value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) OffersOrErr() ([]*Offer, error) {
+	if e.loadedTypes[1] {
+		return e.Offers, nil
+	}
+	return nil, &NotLoadedError{edge: "offers"}
+}
+
+// TransfersOrErr returns the Transfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) TransfersOrErr() ([]*MasterTransfer, error) {
+	if e.loadedTypes[2] {
+		return e.Transfers, nil
+	}
+	return nil, &NotLoadedError{edge: "transfers"}
+}
+
+// IdempotencyKeysOrErr returns the IdempotencyKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) IdempotencyKeysOrErr() ([]*IdempotencyKey, error) {
+	if e.loadedTypes[3] {
+		return e.IdempotencyKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "idempotency_keys"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldPrice:
+		case order.FieldPrice, order.FieldLongitude, order.FieldLatitude:
 			values[i] = new(sql.NullFloat64)
-		case order.FieldTitle, order.FieldDescription, order.FieldAddress, order.FieldLongitude, order.FieldLatitude, order.FieldStatus:
+		case order.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case order.FieldTitle, order.FieldDescription, order.FieldAddress, order.FieldStatus:
 			values[i] = new(sql.NullString)
-		case order.FieldCreatedAt, order.FieldUpdatedAt:
+		case order.FieldCreatedAt, order.FieldUpdatedAt, order.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case order.FieldID, order.FieldCategoryID, order.FieldClientID, order.FieldMasterID:
 			values[i] = new(uuid.UUID)
@@ -104,16 +164,16 @@ func (o *Order) assignValues(columns []string, values []any) error {
 				o.Address = value.String
 			}
 		case order.FieldLongitude:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field longitude", values[i])
 			} else if value.Valid {
-				o.Longitude = value.String
+				o.Longitude = value.Float64
 			}
 		case order.FieldLatitude:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field latitude", values[i])
 			} else if value.Valid {
-				o.Latitude = value.String
+				o.Latitude = value.Float64
 			}
 		case order.FieldCategoryID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
@@ -139,6 +199,12 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.Status = order.Status(value.String)
 			}
+		case order.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				o.Version = int(value.Int64)
+			}
 		case order.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -151,6 +217,13 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.UpdatedAt = value.Time
 			}
+		case order.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				o.DeletedAt = new(time.Time)
+				*o.DeletedAt = value.Time
+			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -164,6 +237,26 @@ func (o *Order) Value(name string) (ent.Value, error) {
 	return o.selectValues.Get(name)
 }
 
+// QueryStatusChanges queries the "status_changes" edge of the Order entity.
+func (o *Order) QueryStatusChanges() *OrderStatusChangeQuery {
+	return NewOrderClient(o.config).QueryStatusChanges(o)
+}
+
+// QueryOffers queries the "offers" edge of the Order entity.
+func (o *Order) QueryOffers() *OfferQuery {
+	return NewOrderClient(o.config).QueryOffers(o)
+}
+
+// QueryTransfers queries the "transfers" edge of the Order entity.
+func (o *Order) QueryTransfers() *MasterTransferQuery {
+	return NewOrderClient(o.config).QueryTransfers(o)
+}
+
+// QueryIdempotencyKeys queries the "idempotency_keys" edge of the Order entity.
+func (o *Order) QueryIdempotencyKeys() *IdempotencyKeyQuery {
+	return NewOrderClient(o.config).QueryIdempotencyKeys(o)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -200,10 +293,10 @@ func (o *Order) String() string {
 	builder.WriteString(o.Address)
 	builder.WriteString(", ")
 	builder.WriteString("longitude=")
-	builder.WriteString(o.Longitude)
+	builder.WriteString(fmt.Sprintf("%v", o.Longitude))
 	builder.WriteString(", ")
 	builder.WriteString("latitude=")
-	builder.WriteString(o.Latitude)
+	builder.WriteString(fmt.Sprintf("%v", o.Latitude))
 	builder.WriteString(", ")
 	builder.WriteString("category_id=")
 	builder.WriteString(fmt.Sprintf("%v", o.CategoryID))
@@ -217,11 +310,19 @@ func (o *Order) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", o.Status))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", o.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(o.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := o.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -76,12 +77,12 @@ func Address(v string) predicate.Order {
 }
 
 // Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
-func Longitude(v string) predicate.Order {
+func Longitude(v float64) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldLongitude, v))
 }
 
 // Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
-func Latitude(v string) predicate.Order {
+func Latitude(v float64) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldLatitude, v))
 }
 
@@ -100,6 +101,11 @@ func MasterID(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldMasterID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -110,6 +116,11 @@ func UpdatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldTitle, v))
@@ -346,135 +357,85 @@ func AddressContainsFold(v string) predicate.Order {
 }
 
 // LongitudeEQ applies the EQ predicate on the "longitude" field.
-func LongitudeEQ(v string) predicate.Order {
+func LongitudeEQ(v float64) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldLongitude, v))
 }
 
 // LongitudeNEQ applies the NEQ predicate on the "longitude" field.
-func LongitudeNEQ(v string) predicate.Order {
+func LongitudeNEQ(v float64) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldLongitude, v))
 }
 
 // LongitudeIn applies the In predicate on the "longitude" field.
-func LongitudeIn(vs ...string) predicate.Order {
+func LongitudeIn(vs ...float64) predicate.Order {
 	return predicate.Order(sql.FieldIn(FieldLongitude, vs...))
 }
 
 // LongitudeNotIn applies the NotIn predicate on the "longitude" field.
-func LongitudeNotIn(vs ...string) predicate.Order {
+func LongitudeNotIn(vs ...float64) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldLongitude, vs...))
 }
 
 // LongitudeGT applies the GT predicate on the "longitude" field.
-func LongitudeGT(v string) predicate.Order {
+func LongitudeGT(v float64) predicate.Order {
 	return predicate.Order(sql.FieldGT(FieldLongitude, v))
 }
 
 // LongitudeGTE applies the GTE predicate on the "longitude" field.
-func LongitudeGTE(v string) predicate.Order {
+func LongitudeGTE(v float64) predicate.Order {
 	return predicate.Order(sql.FieldGTE(FieldLongitude, v))
 }
 
 // LongitudeLT applies the LT predicate on the "longitude" field.
-func LongitudeLT(v string) predicate.Order {
+func LongitudeLT(v float64) predicate.Order {
 	return predicate.Order(sql.FieldLT(FieldLongitude, v))
 }
 
 // LongitudeLTE applies the LTE predicate on the "longitude" field.
-func LongitudeLTE(v string) predicate.Order {
+func LongitudeLTE(v float64) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldLongitude, v))
 }
 
-// LongitudeContains applies the Contains predicate on the "longitude" field.
-func LongitudeContains(v string) predicate.Order {
-	return predicate.Order(sql.FieldContains(FieldLongitude, v))
-}
-
-// LongitudeHasPrefix applies the HasPrefix predicate on the "longitude" field.
-func LongitudeHasPrefix(v string) predicate.Order {
-	return predicate.Order(sql.FieldHasPrefix(FieldLongitude, v))
-}
-
-// LongitudeHasSuffix applies the HasSuffix predicate on the "longitude" field.
-func LongitudeHasSuffix(v string) predicate.Order {
-	return predicate.Order(sql.FieldHasSuffix(FieldLongitude, v))
-}
-
-// LongitudeEqualFold applies the EqualFold predicate on the "longitude" field.
-func LongitudeEqualFold(v string) predicate.Order {
-	return predicate.Order(sql.FieldEqualFold(FieldLongitude, v))
-}
-
-// LongitudeContainsFold applies the ContainsFold predicate on the "longitude" field.
-func LongitudeContainsFold(v string) predicate.Order {
-	return predicate.Order(sql.FieldContainsFold(FieldLongitude, v))
-}
-
 // LatitudeEQ applies the EQ predicate on the "latitude" field.
-func LatitudeEQ(v string) predicate.Order {
+func LatitudeEQ(v float64) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldLatitude, v))
 }
 
 // LatitudeNEQ applies the NEQ predicate on the "latitude" field.
-func LatitudeNEQ(v string) predicate.Order {
+func LatitudeNEQ(v float64) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldLatitude, v))
 }
 
 // LatitudeIn applies the In predicate on the "latitude" field.
-func LatitudeIn(vs ...string) predicate.Order {
+func LatitudeIn(vs ...float64) predicate.Order {
 	return predicate.Order(sql.FieldIn(FieldLatitude, vs...))
 }
 
 // LatitudeNotIn applies the NotIn predicate on the "latitude" field.
-func LatitudeNotIn(vs ...string) predicate.Order {
+func LatitudeNotIn(vs ...float64) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldLatitude, vs...))
 }
 
 // LatitudeGT applies the GT predicate on the "latitude" field.
-func LatitudeGT(v string) predicate.Order {
+func LatitudeGT(v float64) predicate.Order {
 	return predicate.Order(sql.FieldGT(FieldLatitude, v))
 }
 
 // LatitudeGTE applies the GTE predicate on the "latitude" field.
-func LatitudeGTE(v string) predicate.Order {
+func LatitudeGTE(v float64) predicate.Order {
 	return predicate.Order(sql.FieldGTE(FieldLatitude, v))
 }
 
 // LatitudeLT applies the LT predicate on the "latitude" field.
-func LatitudeLT(v string) predicate.Order {
+func LatitudeLT(v float64) predicate.Order {
 	return predicate.Order(sql.FieldLT(FieldLatitude, v))
 }
 
 // LatitudeLTE applies the LTE predicate on the "latitude" field.
-func LatitudeLTE(v string) predicate.Order {
+func LatitudeLTE(v float64) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldLatitude, v))
 }
 
-// LatitudeContains applies the Contains predicate on the "latitude" field.
-func LatitudeContains(v string) predicate.Order {
-	return predicate.Order(sql.FieldContains(FieldLatitude, v))
-}
-
-// LatitudeHasPrefix applies the HasPrefix predicate on the "latitude" field.
-func LatitudeHasPrefix(v string) predicate.Order {
-	return predicate.Order(sql.FieldHasPrefix(FieldLatitude, v))
-}
-
-// LatitudeHasSuffix applies the HasSuffix predicate on the "latitude" field.
-func LatitudeHasSuffix(v string) predicate.Order {
-	return predicate.Order(sql.FieldHasSuffix(FieldLatitude, v))
-}
-
-// LatitudeEqualFold applies the EqualFold predicate on the "latitude" field.
-func LatitudeEqualFold(v string) predicate.Order {
-	return predicate.Order(sql.FieldEqualFold(FieldLatitude, v))
-}
-
-// LatitudeContainsFold applies the ContainsFold predicate on the "latitude" field.
-func LatitudeContainsFold(v string) predicate.Order {
-	return predicate.Order(sql.FieldContainsFold(FieldLatitude, v))
-}
-
 // CategoryIDEQ applies the EQ predicate on the "category_id" field.
 func CategoryIDEQ(v uuid.UUID) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCategoryID, v))
@@ -625,6 +586,46 @@ func StatusNotIn(vs ...Status) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
@@ -705,6 +706,148 @@ func UpdatedAtLTE(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasStatusChanges applies the HasEdge predicate on the "status_changes" edge.
+func HasStatusChanges() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusChangesTable, StatusChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusChangesWith applies the HasEdge predicate on the "status_changes" edge with a given conditions (other predicates).
+func HasStatusChangesWith(preds ...predicate.OrderStatusChange) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStatusChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOffers applies the HasEdge predicate on the "offers" edge.
+func HasOffers() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OffersTable, OffersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOffersWith applies the HasEdge predicate on the "offers" edge with a given conditions (other predicates).
+func HasOffersWith(preds ...predicate.Offer) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newOffersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTransfers applies the HasEdge predicate on the "transfers" edge.
+func HasTransfers() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TransfersTable, TransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTransfersWith applies the HasEdge predicate on the "transfers" edge with a given conditions (other predicates).
+func HasTransfersWith(preds ...predicate.MasterTransfer) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdempotencyKeys applies the HasEdge predicate on the "idempotency_keys" edge.
+func HasIdempotencyKeys() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdempotencyKeysTable, IdempotencyKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdempotencyKeysWith applies the HasEdge predicate on the "idempotency_keys" edge with a given conditions (other predicates).
+func HasIdempotencyKeysWith(preds ...predicate.IdempotencyKey) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newIdempotencyKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
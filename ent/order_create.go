@@ -10,7 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
 	"github.com/google/uuid"
 )
 
@@ -54,14 +58,14 @@ func (oc *OrderCreate) SetAddress(s string) *OrderCreate {
 }
 
 // SetLongitude sets the "longitude" field.
-func (oc *OrderCreate) SetLongitude(s string) *OrderCreate {
-	oc.mutation.SetLongitude(s)
+func (oc *OrderCreate) SetLongitude(f float64) *OrderCreate {
+	oc.mutation.SetLongitude(f)
 	return oc
 }
 
 // SetLatitude sets the "latitude" field.
-func (oc *OrderCreate) SetLatitude(s string) *OrderCreate {
-	oc.mutation.SetLatitude(s)
+func (oc *OrderCreate) SetLatitude(f float64) *OrderCreate {
+	oc.mutation.SetLatitude(f)
 	return oc
 }
 
@@ -105,6 +109,20 @@ func (oc *OrderCreate) SetNillableStatus(o *order.Status) *OrderCreate {
 	return oc
 }
 
+// SetVersion sets the "version" field.
+func (oc *OrderCreate) SetVersion(i int) *OrderCreate {
+	oc.mutation.SetVersion(i)
+	return oc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableVersion(i *int) *OrderCreate {
+	if i != nil {
+		oc.SetVersion(*i)
+	}
+	return oc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (oc *OrderCreate) SetCreatedAt(t time.Time) *OrderCreate {
 	oc.mutation.SetCreatedAt(t)
@@ -133,6 +151,20 @@ func (oc *OrderCreate) SetNillableUpdatedAt(t *time.Time) *OrderCreate {
 	return oc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (oc *OrderCreate) SetDeletedAt(t time.Time) *OrderCreate {
+	oc.mutation.SetDeletedAt(t)
+	return oc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableDeletedAt(t *time.Time) *OrderCreate {
+	if t != nil {
+		oc.SetDeletedAt(*t)
+	}
+	return oc
+}
+
 // SetID sets the "id" field.
 func (oc *OrderCreate) SetID(u uuid.UUID) *OrderCreate {
 	oc.mutation.SetID(u)
@@ -147,6 +179,66 @@ func (oc *OrderCreate) SetNillableID(u *uuid.UUID) *OrderCreate {
 	return oc
 }
 
+// AddStatusChangeIDs adds the "status_changes" edge to the OrderStatusChange entity by IDs.
+func (oc *OrderCreate) AddStatusChangeIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddStatusChangeIDs(ids...)
+	return oc
+}
+
+// AddStatusChanges adds the "status_changes" edges to the OrderStatusChange entity.
+func (oc *OrderCreate) AddStatusChanges(o ...*OrderStatusChange) *OrderCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddStatusChangeIDs(ids...)
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (oc *OrderCreate) AddOfferIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddOfferIDs(ids...)
+	return oc
+}
+
+// AddOffers adds the "offers" edges to the Offer entity.
+func (oc *OrderCreate) AddOffers(o ...*Offer) *OrderCreate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddOfferIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the MasterTransfer entity by IDs.
+func (oc *OrderCreate) AddTransferIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddTransferIDs(ids...)
+	return oc
+}
+
+// AddTransfers adds the "transfers" edges to the MasterTransfer entity.
+func (oc *OrderCreate) AddTransfers(m ...*MasterTransfer) *OrderCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return oc.AddTransferIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (oc *OrderCreate) AddIdempotencyKeyIDs(ids ...uuid.UUID) *OrderCreate {
+	oc.mutation.AddIdempotencyKeyIDs(ids...)
+	return oc
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (oc *OrderCreate) AddIdempotencyKeys(i ...*IdempotencyKey) *OrderCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return oc.AddIdempotencyKeyIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -154,7 +246,9 @@ func (oc *OrderCreate) Mutation() *OrderMutation {
 
 // Save creates the Order in the database.
 func (oc *OrderCreate) Save(ctx context.Context) (*Order, error) {
-	oc.defaults()
+	if err := oc.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, oc.sqlSave, oc.mutation, oc.hooks)
 }
 
@@ -181,7 +275,7 @@ func (oc *OrderCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (oc *OrderCreate) defaults() {
+func (oc *OrderCreate) defaults() error {
 	if _, ok := oc.mutation.Price(); !ok {
 		v := order.DefaultPrice
 		oc.mutation.SetPrice(v)
@@ -190,18 +284,32 @@ func (oc *OrderCreate) defaults() {
 		v := order.DefaultStatus
 		oc.mutation.SetStatus(v)
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		v := order.DefaultVersion
+		oc.mutation.SetVersion(v)
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
+		if order.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized order.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := order.DefaultCreatedAt()
 		oc.mutation.SetCreatedAt(v)
 	}
 	if _, ok := oc.mutation.UpdatedAt(); !ok {
+		if order.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized order.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := order.DefaultUpdatedAt()
 		oc.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := oc.mutation.ID(); !ok {
+		if order.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized order.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := order.DefaultID()
 		oc.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -217,11 +325,6 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Order.description"`)}
 	}
-	if v, ok := oc.mutation.Description(); ok {
-		if err := order.DescriptionValidator(v); err != nil {
-			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Order.description": %w`, err)}
-		}
-	}
 	if _, ok := oc.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Order.price"`)}
 	}
@@ -263,6 +366,9 @@ func (oc *OrderCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Order.version"`)}
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Order.created_at"`)}
 	}
@@ -321,11 +427,11 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_node.Address = value
 	}
 	if value, ok := oc.mutation.Longitude(); ok {
-		_spec.SetField(order.FieldLongitude, field.TypeString, value)
+		_spec.SetField(order.FieldLongitude, field.TypeFloat64, value)
 		_node.Longitude = value
 	}
 	if value, ok := oc.mutation.Latitude(); ok {
-		_spec.SetField(order.FieldLatitude, field.TypeString, value)
+		_spec.SetField(order.FieldLatitude, field.TypeFloat64, value)
 		_node.Latitude = value
 	}
 	if value, ok := oc.mutation.CategoryID(); ok {
@@ -344,6 +450,10 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := oc.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := oc.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -352,6 +462,74 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := oc.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := oc.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -11,7 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -93,33 +97,47 @@ func (ou *OrderUpdate) SetNillableAddress(s *string) *OrderUpdate {
 }
 
 // SetLongitude sets the "longitude" field.
-func (ou *OrderUpdate) SetLongitude(s string) *OrderUpdate {
-	ou.mutation.SetLongitude(s)
+func (ou *OrderUpdate) SetLongitude(f float64) *OrderUpdate {
+	ou.mutation.ResetLongitude()
+	ou.mutation.SetLongitude(f)
 	return ou
 }
 
 // SetNillableLongitude sets the "longitude" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableLongitude(s *string) *OrderUpdate {
-	if s != nil {
-		ou.SetLongitude(*s)
+func (ou *OrderUpdate) SetNillableLongitude(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetLongitude(*f)
 	}
 	return ou
 }
 
+// AddLongitude adds f to the "longitude" field.
+func (ou *OrderUpdate) AddLongitude(f float64) *OrderUpdate {
+	ou.mutation.AddLongitude(f)
+	return ou
+}
+
 // SetLatitude sets the "latitude" field.
-func (ou *OrderUpdate) SetLatitude(s string) *OrderUpdate {
-	ou.mutation.SetLatitude(s)
+func (ou *OrderUpdate) SetLatitude(f float64) *OrderUpdate {
+	ou.mutation.ResetLatitude()
+	ou.mutation.SetLatitude(f)
 	return ou
 }
 
 // SetNillableLatitude sets the "latitude" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableLatitude(s *string) *OrderUpdate {
-	if s != nil {
-		ou.SetLatitude(*s)
+func (ou *OrderUpdate) SetNillableLatitude(f *float64) *OrderUpdate {
+	if f != nil {
+		ou.SetLatitude(*f)
 	}
 	return ou
 }
 
+// AddLatitude adds f to the "latitude" field.
+func (ou *OrderUpdate) AddLatitude(f float64) *OrderUpdate {
+	ou.mutation.AddLatitude(f)
+	return ou
+}
+
 // SetCategoryID sets the "category_id" field.
 func (ou *OrderUpdate) SetCategoryID(u uuid.UUID) *OrderUpdate {
 	ou.mutation.SetCategoryID(u)
@@ -182,6 +200,27 @@ func (ou *OrderUpdate) SetNillableStatus(o *order.Status) *OrderUpdate {
 	return ou
 }
 
+// SetVersion sets the "version" field.
+func (ou *OrderUpdate) SetVersion(i int) *OrderUpdate {
+	ou.mutation.ResetVersion()
+	ou.mutation.SetVersion(i)
+	return ou
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableVersion(i *int) *OrderUpdate {
+	if i != nil {
+		ou.SetVersion(*i)
+	}
+	return ou
+}
+
+// AddVersion adds i to the "version" field.
+func (ou *OrderUpdate) AddVersion(i int) *OrderUpdate {
+	ou.mutation.AddVersion(i)
+	return ou
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ou *OrderUpdate) SetCreatedAt(t time.Time) *OrderUpdate {
 	ou.mutation.SetCreatedAt(t)
@@ -202,14 +241,180 @@ func (ou *OrderUpdate) SetUpdatedAt(t time.Time) *OrderUpdate {
 	return ou
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ou *OrderUpdate) SetDeletedAt(t time.Time) *OrderUpdate {
+	ou.mutation.SetDeletedAt(t)
+	return ou
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableDeletedAt(t *time.Time) *OrderUpdate {
+	if t != nil {
+		ou.SetDeletedAt(*t)
+	}
+	return ou
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ou *OrderUpdate) ClearDeletedAt() *OrderUpdate {
+	ou.mutation.ClearDeletedAt()
+	return ou
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the OrderStatusChange entity by IDs.
+func (ou *OrderUpdate) AddStatusChangeIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddStatusChangeIDs(ids...)
+	return ou
+}
+
+// AddStatusChanges adds the "status_changes" edges to the OrderStatusChange entity.
+func (ou *OrderUpdate) AddStatusChanges(o ...*OrderStatusChange) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddStatusChangeIDs(ids...)
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (ou *OrderUpdate) AddOfferIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddOfferIDs(ids...)
+	return ou
+}
+
+// AddOffers adds the "offers" edges to the Offer entity.
+func (ou *OrderUpdate) AddOffers(o ...*Offer) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddOfferIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the MasterTransfer entity by IDs.
+func (ou *OrderUpdate) AddTransferIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddTransferIDs(ids...)
+	return ou
+}
+
+// AddTransfers adds the "transfers" edges to the MasterTransfer entity.
+func (ou *OrderUpdate) AddTransfers(m ...*MasterTransfer) *OrderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ou.AddTransferIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (ou *OrderUpdate) AddIdempotencyKeyIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.AddIdempotencyKeyIDs(ids...)
+	return ou
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (ou *OrderUpdate) AddIdempotencyKeys(i ...*IdempotencyKey) *OrderUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.AddIdempotencyKeyIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
 }
 
+// ClearStatusChanges clears all "status_changes" edges to the OrderStatusChange entity.
+func (ou *OrderUpdate) ClearStatusChanges() *OrderUpdate {
+	ou.mutation.ClearStatusChanges()
+	return ou
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to OrderStatusChange entities by IDs.
+func (ou *OrderUpdate) RemoveStatusChangeIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveStatusChangeIDs(ids...)
+	return ou
+}
+
+// RemoveStatusChanges removes "status_changes" edges to OrderStatusChange entities.
+func (ou *OrderUpdate) RemoveStatusChanges(o ...*OrderStatusChange) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearOffers clears all "offers" edges to the Offer entity.
+func (ou *OrderUpdate) ClearOffers() *OrderUpdate {
+	ou.mutation.ClearOffers()
+	return ou
+}
+
+// RemoveOfferIDs removes the "offers" edge to Offer entities by IDs.
+func (ou *OrderUpdate) RemoveOfferIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveOfferIDs(ids...)
+	return ou
+}
+
+// RemoveOffers removes "offers" edges to Offer entities.
+func (ou *OrderUpdate) RemoveOffers(o ...*Offer) *OrderUpdate {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveOfferIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the MasterTransfer entity.
+func (ou *OrderUpdate) ClearTransfers() *OrderUpdate {
+	ou.mutation.ClearTransfers()
+	return ou
+}
+
+// RemoveTransferIDs removes the "transfers" edge to MasterTransfer entities by IDs.
+func (ou *OrderUpdate) RemoveTransferIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveTransferIDs(ids...)
+	return ou
+}
+
+// RemoveTransfers removes "transfers" edges to MasterTransfer entities.
+func (ou *OrderUpdate) RemoveTransfers(m ...*MasterTransfer) *OrderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ou.RemoveTransferIDs(ids...)
+}
+
+// ClearIdempotencyKeys clears all "idempotency_keys" edges to the IdempotencyKey entity.
+func (ou *OrderUpdate) ClearIdempotencyKeys() *OrderUpdate {
+	ou.mutation.ClearIdempotencyKeys()
+	return ou
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to IdempotencyKey entities by IDs.
+func (ou *OrderUpdate) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) *OrderUpdate {
+	ou.mutation.RemoveIdempotencyKeyIDs(ids...)
+	return ou
+}
+
+// RemoveIdempotencyKeys removes "idempotency_keys" edges to IdempotencyKey entities.
+func (ou *OrderUpdate) RemoveIdempotencyKeys(i ...*IdempotencyKey) *OrderUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ou.RemoveIdempotencyKeyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
-	ou.defaults()
+	if err := ou.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
 }
 
@@ -236,11 +441,15 @@ func (ou *OrderUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ou *OrderUpdate) defaults() {
+func (ou *OrderUpdate) defaults() error {
 	if _, ok := ou.mutation.UpdatedAt(); !ok {
+		if order.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized order.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := order.UpdateDefaultUpdatedAt()
 		ou.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -250,11 +459,6 @@ func (ou *OrderUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Order.title": %w`, err)}
 		}
 	}
-	if v, ok := ou.mutation.Description(); ok {
-		if err := order.DescriptionValidator(v); err != nil {
-			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Order.description": %w`, err)}
-		}
-	}
 	if v, ok := ou.mutation.Address(); ok {
 		if err := order.AddressValidator(v); err != nil {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Order.address": %w`, err)}
@@ -306,10 +510,16 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.SetField(order.FieldAddress, field.TypeString, value)
 	}
 	if value, ok := ou.mutation.Longitude(); ok {
-		_spec.SetField(order.FieldLongitude, field.TypeString, value)
+		_spec.SetField(order.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedLongitude(); ok {
+		_spec.AddField(order.FieldLongitude, field.TypeFloat64, value)
 	}
 	if value, ok := ou.mutation.Latitude(); ok {
-		_spec.SetField(order.FieldLatitude, field.TypeString, value)
+		_spec.SetField(order.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := ou.mutation.AddedLatitude(); ok {
+		_spec.AddField(order.FieldLatitude, field.TypeFloat64, value)
 	}
 	if value, ok := ou.mutation.CategoryID(); ok {
 		_spec.SetField(order.FieldCategoryID, field.TypeUUID, value)
@@ -326,12 +536,204 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ou.mutation.Status(); ok {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := ou.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ou.mutation.AddedVersion(); ok {
+		_spec.AddField(order.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ou.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := ou.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ou.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ou.mutation.DeletedAtCleared() {
+		_spec.ClearField(order.FieldDeletedAt, field.TypeTime)
+	}
+	if ou.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !ou.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedOffersIDs(); len(nodes) > 0 && !ou.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !ou.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedIdempotencyKeysIDs(); len(nodes) > 0 && !ou.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -416,33 +818,47 @@ func (ouo *OrderUpdateOne) SetNillableAddress(s *string) *OrderUpdateOne {
 }
 
 // SetLongitude sets the "longitude" field.
-func (ouo *OrderUpdateOne) SetLongitude(s string) *OrderUpdateOne {
-	ouo.mutation.SetLongitude(s)
+func (ouo *OrderUpdateOne) SetLongitude(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetLongitude()
+	ouo.mutation.SetLongitude(f)
 	return ouo
 }
 
 // SetNillableLongitude sets the "longitude" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableLongitude(s *string) *OrderUpdateOne {
-	if s != nil {
-		ouo.SetLongitude(*s)
+func (ouo *OrderUpdateOne) SetNillableLongitude(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetLongitude(*f)
 	}
 	return ouo
 }
 
+// AddLongitude adds f to the "longitude" field.
+func (ouo *OrderUpdateOne) AddLongitude(f float64) *OrderUpdateOne {
+	ouo.mutation.AddLongitude(f)
+	return ouo
+}
+
 // SetLatitude sets the "latitude" field.
-func (ouo *OrderUpdateOne) SetLatitude(s string) *OrderUpdateOne {
-	ouo.mutation.SetLatitude(s)
+func (ouo *OrderUpdateOne) SetLatitude(f float64) *OrderUpdateOne {
+	ouo.mutation.ResetLatitude()
+	ouo.mutation.SetLatitude(f)
 	return ouo
 }
 
 // SetNillableLatitude sets the "latitude" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableLatitude(s *string) *OrderUpdateOne {
-	if s != nil {
-		ouo.SetLatitude(*s)
+func (ouo *OrderUpdateOne) SetNillableLatitude(f *float64) *OrderUpdateOne {
+	if f != nil {
+		ouo.SetLatitude(*f)
 	}
 	return ouo
 }
 
+// AddLatitude adds f to the "latitude" field.
+func (ouo *OrderUpdateOne) AddLatitude(f float64) *OrderUpdateOne {
+	ouo.mutation.AddLatitude(f)
+	return ouo
+}
+
 // SetCategoryID sets the "category_id" field.
 func (ouo *OrderUpdateOne) SetCategoryID(u uuid.UUID) *OrderUpdateOne {
 	ouo.mutation.SetCategoryID(u)
@@ -505,6 +921,27 @@ func (ouo *OrderUpdateOne) SetNillableStatus(o *order.Status) *OrderUpdateOne {
 	return ouo
 }
 
+// SetVersion sets the "version" field.
+func (ouo *OrderUpdateOne) SetVersion(i int) *OrderUpdateOne {
+	ouo.mutation.ResetVersion()
+	ouo.mutation.SetVersion(i)
+	return ouo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableVersion(i *int) *OrderUpdateOne {
+	if i != nil {
+		ouo.SetVersion(*i)
+	}
+	return ouo
+}
+
+// AddVersion adds i to the "version" field.
+func (ouo *OrderUpdateOne) AddVersion(i int) *OrderUpdateOne {
+	ouo.mutation.AddVersion(i)
+	return ouo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ouo *OrderUpdateOne) SetCreatedAt(t time.Time) *OrderUpdateOne {
 	ouo.mutation.SetCreatedAt(t)
@@ -525,11 +962,175 @@ func (ouo *OrderUpdateOne) SetUpdatedAt(t time.Time) *OrderUpdateOne {
 	return ouo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ouo *OrderUpdateOne) SetDeletedAt(t time.Time) *OrderUpdateOne {
+	ouo.mutation.SetDeletedAt(t)
+	return ouo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableDeletedAt(t *time.Time) *OrderUpdateOne {
+	if t != nil {
+		ouo.SetDeletedAt(*t)
+	}
+	return ouo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ouo *OrderUpdateOne) ClearDeletedAt() *OrderUpdateOne {
+	ouo.mutation.ClearDeletedAt()
+	return ouo
+}
+
+// AddStatusChangeIDs adds the "status_changes" edge to the OrderStatusChange entity by IDs.
+func (ouo *OrderUpdateOne) AddStatusChangeIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddStatusChangeIDs(ids...)
+	return ouo
+}
+
+// AddStatusChanges adds the "status_changes" edges to the OrderStatusChange entity.
+func (ouo *OrderUpdateOne) AddStatusChanges(o ...*OrderStatusChange) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddStatusChangeIDs(ids...)
+}
+
+// AddOfferIDs adds the "offers" edge to the Offer entity by IDs.
+func (ouo *OrderUpdateOne) AddOfferIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddOfferIDs(ids...)
+	return ouo
+}
+
+// AddOffers adds the "offers" edges to the Offer entity.
+func (ouo *OrderUpdateOne) AddOffers(o ...*Offer) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddOfferIDs(ids...)
+}
+
+// AddTransferIDs adds the "transfers" edge to the MasterTransfer entity by IDs.
+func (ouo *OrderUpdateOne) AddTransferIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddTransferIDs(ids...)
+	return ouo
+}
+
+// AddTransfers adds the "transfers" edges to the MasterTransfer entity.
+func (ouo *OrderUpdateOne) AddTransfers(m ...*MasterTransfer) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ouo.AddTransferIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (ouo *OrderUpdateOne) AddIdempotencyKeyIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.AddIdempotencyKeyIDs(ids...)
+	return ouo
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (ouo *OrderUpdateOne) AddIdempotencyKeys(i ...*IdempotencyKey) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.AddIdempotencyKeyIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
 }
 
+// ClearStatusChanges clears all "status_changes" edges to the OrderStatusChange entity.
+func (ouo *OrderUpdateOne) ClearStatusChanges() *OrderUpdateOne {
+	ouo.mutation.ClearStatusChanges()
+	return ouo
+}
+
+// RemoveStatusChangeIDs removes the "status_changes" edge to OrderStatusChange entities by IDs.
+func (ouo *OrderUpdateOne) RemoveStatusChangeIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveStatusChangeIDs(ids...)
+	return ouo
+}
+
+// RemoveStatusChanges removes "status_changes" edges to OrderStatusChange entities.
+func (ouo *OrderUpdateOne) RemoveStatusChanges(o ...*OrderStatusChange) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveStatusChangeIDs(ids...)
+}
+
+// ClearOffers clears all "offers" edges to the Offer entity.
+func (ouo *OrderUpdateOne) ClearOffers() *OrderUpdateOne {
+	ouo.mutation.ClearOffers()
+	return ouo
+}
+
+// RemoveOfferIDs removes the "offers" edge to Offer entities by IDs.
+func (ouo *OrderUpdateOne) RemoveOfferIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveOfferIDs(ids...)
+	return ouo
+}
+
+// RemoveOffers removes "offers" edges to Offer entities.
+func (ouo *OrderUpdateOne) RemoveOffers(o ...*Offer) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveOfferIDs(ids...)
+}
+
+// ClearTransfers clears all "transfers" edges to the MasterTransfer entity.
+func (ouo *OrderUpdateOne) ClearTransfers() *OrderUpdateOne {
+	ouo.mutation.ClearTransfers()
+	return ouo
+}
+
+// RemoveTransferIDs removes the "transfers" edge to MasterTransfer entities by IDs.
+func (ouo *OrderUpdateOne) RemoveTransferIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveTransferIDs(ids...)
+	return ouo
+}
+
+// RemoveTransfers removes "transfers" edges to MasterTransfer entities.
+func (ouo *OrderUpdateOne) RemoveTransfers(m ...*MasterTransfer) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return ouo.RemoveTransferIDs(ids...)
+}
+
+// ClearIdempotencyKeys clears all "idempotency_keys" edges to the IdempotencyKey entity.
+func (ouo *OrderUpdateOne) ClearIdempotencyKeys() *OrderUpdateOne {
+	ouo.mutation.ClearIdempotencyKeys()
+	return ouo
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to IdempotencyKey entities by IDs.
+func (ouo *OrderUpdateOne) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) *OrderUpdateOne {
+	ouo.mutation.RemoveIdempotencyKeyIDs(ids...)
+	return ouo
+}
+
+// RemoveIdempotencyKeys removes "idempotency_keys" edges to IdempotencyKey entities.
+func (ouo *OrderUpdateOne) RemoveIdempotencyKeys(i ...*IdempotencyKey) *OrderUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ouo.RemoveIdempotencyKeyIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -545,7 +1146,9 @@ func (ouo *OrderUpdateOne) Select(field string, fields ...string) *OrderUpdateOn
 
 // Save executes the query and returns the updated Order entity.
 func (ouo *OrderUpdateOne) Save(ctx context.Context) (*Order, error) {
-	ouo.defaults()
+	if err := ouo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ouo.sqlSave, ouo.mutation, ouo.hooks)
 }
 
@@ -572,11 +1175,15 @@ func (ouo *OrderUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ouo *OrderUpdateOne) defaults() {
+func (ouo *OrderUpdateOne) defaults() error {
 	if _, ok := ouo.mutation.UpdatedAt(); !ok {
+		if order.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized order.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := order.UpdateDefaultUpdatedAt()
 		ouo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -586,11 +1193,6 @@ func (ouo *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Order.title": %w`, err)}
 		}
 	}
-	if v, ok := ouo.mutation.Description(); ok {
-		if err := order.DescriptionValidator(v); err != nil {
-			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Order.description": %w`, err)}
-		}
-	}
 	if v, ok := ouo.mutation.Address(); ok {
 		if err := order.AddressValidator(v); err != nil {
 			return &ValidationError{Name: "address", err: fmt.Errorf(`ent: validator failed for field "Order.address": %w`, err)}
@@ -659,10 +1261,16 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		_spec.SetField(order.FieldAddress, field.TypeString, value)
 	}
 	if value, ok := ouo.mutation.Longitude(); ok {
-		_spec.SetField(order.FieldLongitude, field.TypeString, value)
+		_spec.SetField(order.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedLongitude(); ok {
+		_spec.AddField(order.FieldLongitude, field.TypeFloat64, value)
 	}
 	if value, ok := ouo.mutation.Latitude(); ok {
-		_spec.SetField(order.FieldLatitude, field.TypeString, value)
+		_spec.SetField(order.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := ouo.mutation.AddedLatitude(); ok {
+		_spec.AddField(order.FieldLatitude, field.TypeFloat64, value)
 	}
 	if value, ok := ouo.mutation.CategoryID(); ok {
 		_spec.SetField(order.FieldCategoryID, field.TypeUUID, value)
@@ -679,12 +1287,204 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 	if value, ok := ouo.mutation.Status(); ok {
 		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := ouo.mutation.Version(); ok {
+		_spec.SetField(order.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := ouo.mutation.AddedVersion(); ok {
+		_spec.AddField(order.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := ouo.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
 	if value, ok := ouo.mutation.UpdatedAt(); ok {
 		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := ouo.mutation.DeletedAt(); ok {
+		_spec.SetField(order.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ouo.mutation.DeletedAtCleared() {
+		_spec.ClearField(order.FieldDeletedAt, field.TypeTime)
+	}
+	if ouo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedStatusChangesIDs(); len(nodes) > 0 && !ouo.mutation.StatusChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.StatusChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusChangesTable,
+			Columns: []string{order.StatusChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedOffersIDs(); len(nodes) > 0 && !ouo.mutation.OffersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.OffersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.OffersTable,
+			Columns: []string{order.OffersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(offer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedTransfersIDs(); len(nodes) > 0 && !ouo.mutation.TransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.TransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.TransfersTable,
+			Columns: []string{order.TransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mastertransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedIdempotencyKeysIDs(); len(nodes) > 0 && !ouo.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.IdempotencyKeysTable,
+			Columns: []string{order.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(idempotencykey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
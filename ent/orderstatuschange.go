@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/google/uuid"
+)
+
+// OrderStatusChange is the model entity for the OrderStatusChange schema.
+type OrderStatusChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID заказа
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// Предыдущий статус, пусто при создании заказа
+	FromStatus *orderstatuschange.FromStatus `json:"from_status,omitempty"`
+	// Новый статус
+	ToStatus orderstatuschange.ToStatus `json:"to_status,omitempty"`
+	// ID пользователя, изменившего статус
+	ActorID uuid.UUID `json:"actor_id,omitempty"`
+	// Причина изменения
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderStatusChangeQuery when eager-loading is set.
+	Edges        OrderStatusChangeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderStatusChangeEdges holds the relations/edges for other nodes in the graph.
+type OrderStatusChangeEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusChangeEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderStatusChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderstatuschange.FieldFromStatus, orderstatuschange.FieldToStatus, orderstatuschange.FieldReason:
+			values[i] = new(sql.NullString)
+		case orderstatuschange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case orderstatuschange.FieldID, orderstatuschange.FieldOrderID, orderstatuschange.FieldActorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderStatusChange fields.
+func (osc *OrderStatusChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderstatuschange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				osc.ID = *value
+			}
+		case orderstatuschange.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				osc.OrderID = *value
+			}
+		case orderstatuschange.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				osc.FromStatus = new(orderstatuschange.FromStatus)
+				*osc.FromStatus = orderstatuschange.FromStatus(value.String)
+			}
+		case orderstatuschange.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				osc.ToStatus = orderstatuschange.ToStatus(value.String)
+			}
+		case orderstatuschange.FieldActorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value != nil {
+				osc.ActorID = *value
+			}
+		case orderstatuschange.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				osc.Reason = value.String
+			}
+		case orderstatuschange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				osc.CreatedAt = value.Time
+			}
+		default:
+			osc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderStatusChange.
+// This includes values selected through modifiers, order, etc.
+func (osc *OrderStatusChange) Value(name string) (ent.Value, error) {
+	return osc.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderStatusChange entity.
+func (osc *OrderStatusChange) QueryOrder() *OrderQuery {
+	return NewOrderStatusChangeClient(osc.config).QueryOrder(osc)
+}
+
+// Update returns a builder for updating this OrderStatusChange.
+// Note that you need to call OrderStatusChange.Unwrap() before calling this method if this OrderStatusChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (osc *OrderStatusChange) Update() *OrderStatusChangeUpdateOne {
+	return NewOrderStatusChangeClient(osc.config).UpdateOne(osc)
+}
+
+// Unwrap unwraps the OrderStatusChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (osc *OrderStatusChange) Unwrap() *OrderStatusChange {
+	_tx, ok := osc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderStatusChange is not a transactional entity")
+	}
+	osc.config.driver = _tx.drv
+	return osc
+}
+
+// String implements the fmt.Stringer.
+func (osc *OrderStatusChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderStatusChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", osc.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", osc.OrderID))
+	builder.WriteString(", ")
+	if v := osc.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", osc.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", osc.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(osc.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(osc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderStatusChanges is a parsable slice of OrderStatusChange.
+type OrderStatusChanges []*OrderStatusChange
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderstatuschange
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the orderstatuschange type in the database.
+	Label = "order_status_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// Table holds the table name of the orderstatuschange in the database.
+	Table = "order_status_changes"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "order_status_changes"
+	// OrderInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrderInverseTable = "orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+)
+
+// Columns holds all SQL columns for orderstatuschange fields.
+var Columns = []string{
+	FieldID,
+	FieldOrderID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldActorID,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusActive     FromStatus = "active"
+	FromStatusInProgress FromStatus = "in_progress"
+	FromStatusCancel     FromStatus = "cancel"
+	FromStatusDone       FromStatus = "done"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusActive, FromStatusInProgress, FromStatusCancel, FromStatusDone:
+		return nil
+	default:
+		return fmt.Errorf("orderstatuschange: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusActive     ToStatus = "active"
+	ToStatusInProgress ToStatus = "in_progress"
+	ToStatusCancel     ToStatus = "cancel"
+	ToStatusDone       ToStatus = "done"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusActive, ToStatusInProgress, ToStatusCancel, ToStatusDone:
+		return nil
+	default:
+		return fmt.Errorf("orderstatuschange: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the OrderStatusChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
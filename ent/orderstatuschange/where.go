@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderstatuschange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLTE(FieldID, id))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldOrderID, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldActorID, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v FromStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v FromStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...FromStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...FromStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotNull(FieldFromStatus))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v ToStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v ToStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...ToStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...ToStatus) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotNull(FieldActorID))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrderStatusChange) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrderStatusChange) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrderStatusChange) predicate.OrderStatusChange {
+	return predicate.OrderStatusChange(sql.NotPredicates(p))
+}
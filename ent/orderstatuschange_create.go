@@ -0,0 +1,345 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/google/uuid"
+)
+
+// OrderStatusChangeCreate is the builder for creating a OrderStatusChange entity.
+type OrderStatusChangeCreate struct {
+	config
+	mutation *OrderStatusChangeMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (oscc *OrderStatusChangeCreate) SetOrderID(u uuid.UUID) *OrderStatusChangeCreate {
+	oscc.mutation.SetOrderID(u)
+	return oscc
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oscc *OrderStatusChangeCreate) SetFromStatus(os orderstatuschange.FromStatus) *OrderStatusChangeCreate {
+	oscc.mutation.SetFromStatus(os)
+	return oscc
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oscc *OrderStatusChangeCreate) SetNillableFromStatus(os *orderstatuschange.FromStatus) *OrderStatusChangeCreate {
+	if os != nil {
+		oscc.SetFromStatus(*os)
+	}
+	return oscc
+}
+
+// SetToStatus sets the "to_status" field.
+func (oscc *OrderStatusChangeCreate) SetToStatus(os orderstatuschange.ToStatus) *OrderStatusChangeCreate {
+	oscc.mutation.SetToStatus(os)
+	return oscc
+}
+
+// SetActorID sets the "actor_id" field.
+func (oscc *OrderStatusChangeCreate) SetActorID(u uuid.UUID) *OrderStatusChangeCreate {
+	oscc.mutation.SetActorID(u)
+	return oscc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (oscc *OrderStatusChangeCreate) SetNillableActorID(u *uuid.UUID) *OrderStatusChangeCreate {
+	if u != nil {
+		oscc.SetActorID(*u)
+	}
+	return oscc
+}
+
+// SetReason sets the "reason" field.
+func (oscc *OrderStatusChangeCreate) SetReason(s string) *OrderStatusChangeCreate {
+	oscc.mutation.SetReason(s)
+	return oscc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (oscc *OrderStatusChangeCreate) SetNillableReason(s *string) *OrderStatusChangeCreate {
+	if s != nil {
+		oscc.SetReason(*s)
+	}
+	return oscc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oscc *OrderStatusChangeCreate) SetCreatedAt(t time.Time) *OrderStatusChangeCreate {
+	oscc.mutation.SetCreatedAt(t)
+	return oscc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (oscc *OrderStatusChangeCreate) SetNillableCreatedAt(t *time.Time) *OrderStatusChangeCreate {
+	if t != nil {
+		oscc.SetCreatedAt(*t)
+	}
+	return oscc
+}
+
+// SetID sets the "id" field.
+func (oscc *OrderStatusChangeCreate) SetID(u uuid.UUID) *OrderStatusChangeCreate {
+	oscc.mutation.SetID(u)
+	return oscc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (oscc *OrderStatusChangeCreate) SetNillableID(u *uuid.UUID) *OrderStatusChangeCreate {
+	if u != nil {
+		oscc.SetID(*u)
+	}
+	return oscc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oscc *OrderStatusChangeCreate) SetOrder(o *Order) *OrderStatusChangeCreate {
+	return oscc.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusChangeMutation object of the builder.
+func (oscc *OrderStatusChangeCreate) Mutation() *OrderStatusChangeMutation {
+	return oscc.mutation
+}
+
+// Save creates the OrderStatusChange in the database.
+func (oscc *OrderStatusChangeCreate) Save(ctx context.Context) (*OrderStatusChange, error) {
+	oscc.defaults()
+	return withHooks(ctx, oscc.sqlSave, oscc.mutation, oscc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oscc *OrderStatusChangeCreate) SaveX(ctx context.Context) *OrderStatusChange {
+	v, err := oscc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oscc *OrderStatusChangeCreate) Exec(ctx context.Context) error {
+	_, err := oscc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscc *OrderStatusChangeCreate) ExecX(ctx context.Context) {
+	if err := oscc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (oscc *OrderStatusChangeCreate) defaults() {
+	if _, ok := oscc.mutation.Reason(); !ok {
+		v := orderstatuschange.DefaultReason
+		oscc.mutation.SetReason(v)
+	}
+	if _, ok := oscc.mutation.CreatedAt(); !ok {
+		v := orderstatuschange.DefaultCreatedAt()
+		oscc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := oscc.mutation.ID(); !ok {
+		v := orderstatuschange.DefaultID()
+		oscc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oscc *OrderStatusChangeCreate) check() error {
+	if _, ok := oscc.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "OrderStatusChange.order_id"`)}
+	}
+	if v, ok := oscc.mutation.FromStatus(); ok {
+		if err := orderstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.from_status": %w`, err)}
+		}
+	}
+	if _, ok := oscc.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "OrderStatusChange.to_status"`)}
+	}
+	if v, ok := oscc.mutation.ToStatus(); ok {
+		if err := orderstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.to_status": %w`, err)}
+		}
+	}
+	if _, ok := oscc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "OrderStatusChange.reason"`)}
+	}
+	if _, ok := oscc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderStatusChange.created_at"`)}
+	}
+	if len(oscc.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "OrderStatusChange.order"`)}
+	}
+	return nil
+}
+
+func (oscc *OrderStatusChangeCreate) sqlSave(ctx context.Context) (*OrderStatusChange, error) {
+	if err := oscc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oscc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oscc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	oscc.mutation.id = &_node.ID
+	oscc.mutation.done = true
+	return _node, nil
+}
+
+func (oscc *OrderStatusChangeCreate) createSpec() (*OrderStatusChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderStatusChange{config: oscc.config}
+		_spec = sqlgraph.NewCreateSpec(orderstatuschange.Table, sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID))
+	)
+	if id, ok := oscc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := oscc.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := oscc.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := oscc.mutation.ActorID(); ok {
+		_spec.SetField(orderstatuschange.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = value
+	}
+	if value, ok := oscc.mutation.Reason(); ok {
+		_spec.SetField(orderstatuschange.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := oscc.mutation.CreatedAt(); ok {
+		_spec.SetField(orderstatuschange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := oscc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatuschange.OrderTable,
+			Columns: []string{orderstatuschange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderStatusChangeCreateBulk is the builder for creating many OrderStatusChange entities in bulk.
+type OrderStatusChangeCreateBulk struct {
+	config
+	err      error
+	builders []*OrderStatusChangeCreate
+}
+
+// Save creates the OrderStatusChange entities in the database.
+func (osccb *OrderStatusChangeCreateBulk) Save(ctx context.Context) ([]*OrderStatusChange, error) {
+	if osccb.err != nil {
+		return nil, osccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(osccb.builders))
+	nodes := make([]*OrderStatusChange, len(osccb.builders))
+	mutators := make([]Mutator, len(osccb.builders))
+	for i := range osccb.builders {
+		func(i int, root context.Context) {
+			builder := osccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderStatusChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, osccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, osccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, osccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osccb *OrderStatusChangeCreateBulk) SaveX(ctx context.Context) []*OrderStatusChange {
+	v, err := osccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (osccb *OrderStatusChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := osccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osccb *OrderStatusChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := osccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+// OrderStatusChangeDelete is the builder for deleting a OrderStatusChange entity.
+type OrderStatusChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusChangeMutation
+}
+
+// Where appends a list predicates to the OrderStatusChangeDelete builder.
+func (oscd *OrderStatusChangeDelete) Where(ps ...predicate.OrderStatusChange) *OrderStatusChangeDelete {
+	oscd.mutation.Where(ps...)
+	return oscd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (oscd *OrderStatusChangeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, oscd.sqlExec, oscd.mutation, oscd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscd *OrderStatusChangeDelete) ExecX(ctx context.Context) int {
+	n, err := oscd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (oscd *OrderStatusChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orderstatuschange.Table, sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID))
+	if ps := oscd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, oscd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	oscd.mutation.done = true
+	return affected, err
+}
+
+// OrderStatusChangeDeleteOne is the builder for deleting a single OrderStatusChange entity.
+type OrderStatusChangeDeleteOne struct {
+	oscd *OrderStatusChangeDelete
+}
+
+// Where appends a list predicates to the OrderStatusChangeDelete builder.
+func (oscdo *OrderStatusChangeDeleteOne) Where(ps ...predicate.OrderStatusChange) *OrderStatusChangeDeleteOne {
+	oscdo.oscd.mutation.Where(ps...)
+	return oscdo
+}
+
+// Exec executes the deletion query.
+func (oscdo *OrderStatusChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := oscdo.oscd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orderstatuschange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscdo *OrderStatusChangeDeleteOne) ExecX(ctx context.Context) {
+	if err := oscdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
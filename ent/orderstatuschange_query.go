@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OrderStatusChangeQuery is the builder for querying OrderStatusChange entities.
+type OrderStatusChangeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []orderstatuschange.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OrderStatusChange
+	withOrder  *OrderQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrderStatusChangeQuery builder.
+func (oscq *OrderStatusChangeQuery) Where(ps ...predicate.OrderStatusChange) *OrderStatusChangeQuery {
+	oscq.predicates = append(oscq.predicates, ps...)
+	return oscq
+}
+
+// Limit the number of records to be returned by this query.
+func (oscq *OrderStatusChangeQuery) Limit(limit int) *OrderStatusChangeQuery {
+	oscq.ctx.Limit = &limit
+	return oscq
+}
+
+// Offset to start from.
+func (oscq *OrderStatusChangeQuery) Offset(offset int) *OrderStatusChangeQuery {
+	oscq.ctx.Offset = &offset
+	return oscq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oscq *OrderStatusChangeQuery) Unique(unique bool) *OrderStatusChangeQuery {
+	oscq.ctx.Unique = &unique
+	return oscq
+}
+
+// Order specifies how the records should be ordered.
+func (oscq *OrderStatusChangeQuery) Order(o ...orderstatuschange.OrderOption) *OrderStatusChangeQuery {
+	oscq.order = append(oscq.order, o...)
+	return oscq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (oscq *OrderStatusChangeQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: oscq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oscq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oscq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatuschange.Table, orderstatuschange.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatuschange.OrderTable, orderstatuschange.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oscq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrderStatusChange entity from the query.
+// Returns a *NotFoundError when no OrderStatusChange was found.
+func (oscq *OrderStatusChangeQuery) First(ctx context.Context) (*OrderStatusChange, error) {
+	nodes, err := oscq.Limit(1).All(setContextOp(ctx, oscq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orderstatuschange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) FirstX(ctx context.Context) *OrderStatusChange {
+	node, err := oscq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrderStatusChange ID from the query.
+// Returns a *NotFoundError when no OrderStatusChange ID was found.
+func (oscq *OrderStatusChangeQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oscq.Limit(1).IDs(setContextOp(ctx, oscq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orderstatuschange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := oscq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrderStatusChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrderStatusChange entity is found.
+// Returns a *NotFoundError when no OrderStatusChange entities are found.
+func (oscq *OrderStatusChangeQuery) Only(ctx context.Context) (*OrderStatusChange, error) {
+	nodes, err := oscq.Limit(2).All(setContextOp(ctx, oscq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orderstatuschange.Label}
+	default:
+		return nil, &NotSingularError{orderstatuschange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) OnlyX(ctx context.Context) *OrderStatusChange {
+	node, err := oscq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrderStatusChange ID in the query.
+// Returns a *NotSingularError when more than one OrderStatusChange ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oscq *OrderStatusChangeQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = oscq.Limit(2).IDs(setContextOp(ctx, oscq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orderstatuschange.Label}
+	default:
+		err = &NotSingularError{orderstatuschange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := oscq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrderStatusChanges.
+func (oscq *OrderStatusChangeQuery) All(ctx context.Context) ([]*OrderStatusChange, error) {
+	ctx = setContextOp(ctx, oscq.ctx, ent.OpQueryAll)
+	if err := oscq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrderStatusChange, *OrderStatusChangeQuery]()
+	return withInterceptors[[]*OrderStatusChange](ctx, oscq, qr, oscq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) AllX(ctx context.Context) []*OrderStatusChange {
+	nodes, err := oscq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrderStatusChange IDs.
+func (oscq *OrderStatusChangeQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if oscq.ctx.Unique == nil && oscq.path != nil {
+		oscq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oscq.ctx, ent.OpQueryIDs)
+	if err = oscq.Select(orderstatuschange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := oscq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oscq *OrderStatusChangeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oscq.ctx, ent.OpQueryCount)
+	if err := oscq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oscq, querierCount[*OrderStatusChangeQuery](), oscq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) CountX(ctx context.Context) int {
+	count, err := oscq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oscq *OrderStatusChangeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oscq.ctx, ent.OpQueryExist)
+	switch _, err := oscq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oscq *OrderStatusChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := oscq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrderStatusChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oscq *OrderStatusChangeQuery) Clone() *OrderStatusChangeQuery {
+	if oscq == nil {
+		return nil
+	}
+	return &OrderStatusChangeQuery{
+		config:     oscq.config,
+		ctx:        oscq.ctx.Clone(),
+		order:      append([]orderstatuschange.OrderOption{}, oscq.order...),
+		inters:     append([]Interceptor{}, oscq.inters...),
+		predicates: append([]predicate.OrderStatusChange{}, oscq.predicates...),
+		withOrder:  oscq.withOrder.Clone(),
+		// clone intermediate query.
+		sql:  oscq.sql.Clone(),
+		path: oscq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (oscq *OrderStatusChangeQuery) WithOrder(opts ...func(*OrderQuery)) *OrderStatusChangeQuery {
+	query := (&OrderClient{config: oscq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oscq.withOrder = query
+	return oscq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrderStatusChange.Query().
+//		GroupBy(orderstatuschange.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oscq *OrderStatusChangeQuery) GroupBy(field string, fields ...string) *OrderStatusChangeGroupBy {
+	oscq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrderStatusChangeGroupBy{build: oscq}
+	grbuild.flds = &oscq.ctx.Fields
+	grbuild.label = orderstatuschange.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID uuid.UUID `json:"order_id,omitempty"`
+//	}
+//
+//	client.OrderStatusChange.Query().
+//		Select(orderstatuschange.FieldOrderID).
+//		Scan(ctx, &v)
+func (oscq *OrderStatusChangeQuery) Select(fields ...string) *OrderStatusChangeSelect {
+	oscq.ctx.Fields = append(oscq.ctx.Fields, fields...)
+	sbuild := &OrderStatusChangeSelect{OrderStatusChangeQuery: oscq}
+	sbuild.label = orderstatuschange.Label
+	sbuild.flds, sbuild.scan = &oscq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrderStatusChangeSelect configured with the given aggregations.
+func (oscq *OrderStatusChangeQuery) Aggregate(fns ...AggregateFunc) *OrderStatusChangeSelect {
+	return oscq.Select().Aggregate(fns...)
+}
+
+func (oscq *OrderStatusChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oscq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oscq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oscq.ctx.Fields {
+		if !orderstatuschange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oscq.path != nil {
+		prev, err := oscq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oscq.sql = prev
+	}
+	return nil
+}
+
+func (oscq *OrderStatusChangeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrderStatusChange, error) {
+	var (
+		nodes       = []*OrderStatusChange{}
+		_spec       = oscq.querySpec()
+		loadedTypes = [1]bool{
+			oscq.withOrder != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrderStatusChange).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrderStatusChange{config: oscq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(oscq.modifiers) > 0 {
+		_spec.Modifiers = oscq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oscq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oscq.withOrder; query != nil {
+		if err := oscq.loadOrder(ctx, query, nodes, nil,
+			func(n *OrderStatusChange, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oscq *OrderStatusChangeQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*OrderStatusChange, init func(*OrderStatusChange), assign func(*OrderStatusChange, *Order)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*OrderStatusChange)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oscq *OrderStatusChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oscq.querySpec()
+	if len(oscq.modifiers) > 0 {
+		_spec.Modifiers = oscq.modifiers
+	}
+	_spec.Node.Columns = oscq.ctx.Fields
+	if len(oscq.ctx.Fields) > 0 {
+		_spec.Unique = oscq.ctx.Unique != nil && *oscq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oscq.driver, _spec)
+}
+
+func (oscq *OrderStatusChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orderstatuschange.Table, orderstatuschange.Columns, sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID))
+	_spec.From = oscq.sql
+	if unique := oscq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oscq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oscq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatuschange.FieldID)
+		for i := range fields {
+			if fields[i] != orderstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if oscq.withOrder != nil {
+			_spec.Node.AddColumnOnce(orderstatuschange.FieldOrderID)
+		}
+	}
+	if ps := oscq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oscq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oscq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oscq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oscq *OrderStatusChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oscq.driver.Dialect())
+	t1 := builder.Table(orderstatuschange.Table)
+	columns := oscq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orderstatuschange.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oscq.sql != nil {
+		selector = oscq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oscq.ctx.Unique != nil && *oscq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range oscq.modifiers {
+		m(selector)
+	}
+	for _, p := range oscq.predicates {
+		p(selector)
+	}
+	for _, p := range oscq.order {
+		p(selector)
+	}
+	if offset := oscq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oscq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (oscq *OrderStatusChangeQuery) ForUpdate(opts ...sql.LockOption) *OrderStatusChangeQuery {
+	if oscq.driver.Dialect() == dialect.Postgres {
+		oscq.Unique(false)
+	}
+	oscq.modifiers = append(oscq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return oscq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (oscq *OrderStatusChangeQuery) ForShare(opts ...sql.LockOption) *OrderStatusChangeQuery {
+	if oscq.driver.Dialect() == dialect.Postgres {
+		oscq.Unique(false)
+	}
+	oscq.modifiers = append(oscq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return oscq
+}
+
+// OrderStatusChangeGroupBy is the group-by builder for OrderStatusChange entities.
+type OrderStatusChangeGroupBy struct {
+	selector
+	build *OrderStatusChangeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (oscgb *OrderStatusChangeGroupBy) Aggregate(fns ...AggregateFunc) *OrderStatusChangeGroupBy {
+	oscgb.fns = append(oscgb.fns, fns...)
+	return oscgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oscgb *OrderStatusChangeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oscgb.build.ctx, ent.OpQueryGroupBy)
+	if err := oscgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusChangeQuery, *OrderStatusChangeGroupBy](ctx, oscgb.build, oscgb, oscgb.build.inters, v)
+}
+
+func (oscgb *OrderStatusChangeGroupBy) sqlScan(ctx context.Context, root *OrderStatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(oscgb.fns))
+	for _, fn := range oscgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*oscgb.flds)+len(oscgb.fns))
+		for _, f := range *oscgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*oscgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oscgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrderStatusChangeSelect is the builder for selecting fields of OrderStatusChange entities.
+type OrderStatusChangeSelect struct {
+	*OrderStatusChangeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oscs *OrderStatusChangeSelect) Aggregate(fns ...AggregateFunc) *OrderStatusChangeSelect {
+	oscs.fns = append(oscs.fns, fns...)
+	return oscs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oscs *OrderStatusChangeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oscs.ctx, ent.OpQuerySelect)
+	if err := oscs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusChangeQuery, *OrderStatusChangeSelect](ctx, oscs.OrderStatusChangeQuery, oscs, oscs.inters, v)
+}
+
+func (oscs *OrderStatusChangeSelect) sqlScan(ctx context.Context, root *OrderStatusChangeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oscs.fns))
+	for _, fn := range oscs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oscs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oscs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,499 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OrderStatusChangeUpdate is the builder for updating OrderStatusChange entities.
+type OrderStatusChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusChangeMutation
+}
+
+// Where appends a list predicates to the OrderStatusChangeUpdate builder.
+func (oscu *OrderStatusChangeUpdate) Where(ps ...predicate.OrderStatusChange) *OrderStatusChangeUpdate {
+	oscu.mutation.Where(ps...)
+	return oscu
+}
+
+// SetOrderID sets the "order_id" field.
+func (oscu *OrderStatusChangeUpdate) SetOrderID(u uuid.UUID) *OrderStatusChangeUpdate {
+	oscu.mutation.SetOrderID(u)
+	return oscu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oscu *OrderStatusChangeUpdate) SetNillableOrderID(u *uuid.UUID) *OrderStatusChangeUpdate {
+	if u != nil {
+		oscu.SetOrderID(*u)
+	}
+	return oscu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oscu *OrderStatusChangeUpdate) SetFromStatus(os orderstatuschange.FromStatus) *OrderStatusChangeUpdate {
+	oscu.mutation.SetFromStatus(os)
+	return oscu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oscu *OrderStatusChangeUpdate) SetNillableFromStatus(os *orderstatuschange.FromStatus) *OrderStatusChangeUpdate {
+	if os != nil {
+		oscu.SetFromStatus(*os)
+	}
+	return oscu
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oscu *OrderStatusChangeUpdate) ClearFromStatus() *OrderStatusChangeUpdate {
+	oscu.mutation.ClearFromStatus()
+	return oscu
+}
+
+// SetToStatus sets the "to_status" field.
+func (oscu *OrderStatusChangeUpdate) SetToStatus(os orderstatuschange.ToStatus) *OrderStatusChangeUpdate {
+	oscu.mutation.SetToStatus(os)
+	return oscu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oscu *OrderStatusChangeUpdate) SetNillableToStatus(os *orderstatuschange.ToStatus) *OrderStatusChangeUpdate {
+	if os != nil {
+		oscu.SetToStatus(*os)
+	}
+	return oscu
+}
+
+// SetActorID sets the "actor_id" field.
+func (oscu *OrderStatusChangeUpdate) SetActorID(u uuid.UUID) *OrderStatusChangeUpdate {
+	oscu.mutation.SetActorID(u)
+	return oscu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (oscu *OrderStatusChangeUpdate) SetNillableActorID(u *uuid.UUID) *OrderStatusChangeUpdate {
+	if u != nil {
+		oscu.SetActorID(*u)
+	}
+	return oscu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (oscu *OrderStatusChangeUpdate) ClearActorID() *OrderStatusChangeUpdate {
+	oscu.mutation.ClearActorID()
+	return oscu
+}
+
+// SetReason sets the "reason" field.
+func (oscu *OrderStatusChangeUpdate) SetReason(s string) *OrderStatusChangeUpdate {
+	oscu.mutation.SetReason(s)
+	return oscu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (oscu *OrderStatusChangeUpdate) SetNillableReason(s *string) *OrderStatusChangeUpdate {
+	if s != nil {
+		oscu.SetReason(*s)
+	}
+	return oscu
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oscu *OrderStatusChangeUpdate) SetOrder(o *Order) *OrderStatusChangeUpdate {
+	return oscu.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusChangeMutation object of the builder.
+func (oscu *OrderStatusChangeUpdate) Mutation() *OrderStatusChangeMutation {
+	return oscu.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oscu *OrderStatusChangeUpdate) ClearOrder() *OrderStatusChangeUpdate {
+	oscu.mutation.ClearOrder()
+	return oscu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oscu *OrderStatusChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oscu.sqlSave, oscu.mutation, oscu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oscu *OrderStatusChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := oscu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oscu *OrderStatusChangeUpdate) Exec(ctx context.Context) error {
+	_, err := oscu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscu *OrderStatusChangeUpdate) ExecX(ctx context.Context) {
+	if err := oscu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oscu *OrderStatusChangeUpdate) check() error {
+	if v, ok := oscu.mutation.FromStatus(); ok {
+		if err := orderstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := oscu.mutation.ToStatus(); ok {
+		if err := orderstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.to_status": %w`, err)}
+		}
+	}
+	if oscu.mutation.OrderCleared() && len(oscu.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderStatusChange.order"`)
+	}
+	return nil
+}
+
+func (oscu *OrderStatusChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oscu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatuschange.Table, orderstatuschange.Columns, sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID))
+	if ps := oscu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oscu.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if oscu.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatuschange.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := oscu.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := oscu.mutation.ActorID(); ok {
+		_spec.SetField(orderstatuschange.FieldActorID, field.TypeUUID, value)
+	}
+	if oscu.mutation.ActorIDCleared() {
+		_spec.ClearField(orderstatuschange.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := oscu.mutation.Reason(); ok {
+		_spec.SetField(orderstatuschange.FieldReason, field.TypeString, value)
+	}
+	if oscu.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatuschange.OrderTable,
+			Columns: []string{orderstatuschange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oscu.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatuschange.OrderTable,
+			Columns: []string{orderstatuschange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oscu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oscu.mutation.done = true
+	return n, nil
+}
+
+// OrderStatusChangeUpdateOne is the builder for updating a single OrderStatusChange entity.
+type OrderStatusChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderStatusChangeMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (oscuo *OrderStatusChangeUpdateOne) SetOrderID(u uuid.UUID) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.SetOrderID(u)
+	return oscuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oscuo *OrderStatusChangeUpdateOne) SetNillableOrderID(u *uuid.UUID) *OrderStatusChangeUpdateOne {
+	if u != nil {
+		oscuo.SetOrderID(*u)
+	}
+	return oscuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oscuo *OrderStatusChangeUpdateOne) SetFromStatus(os orderstatuschange.FromStatus) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.SetFromStatus(os)
+	return oscuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oscuo *OrderStatusChangeUpdateOne) SetNillableFromStatus(os *orderstatuschange.FromStatus) *OrderStatusChangeUpdateOne {
+	if os != nil {
+		oscuo.SetFromStatus(*os)
+	}
+	return oscuo
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oscuo *OrderStatusChangeUpdateOne) ClearFromStatus() *OrderStatusChangeUpdateOne {
+	oscuo.mutation.ClearFromStatus()
+	return oscuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (oscuo *OrderStatusChangeUpdateOne) SetToStatus(os orderstatuschange.ToStatus) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.SetToStatus(os)
+	return oscuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oscuo *OrderStatusChangeUpdateOne) SetNillableToStatus(os *orderstatuschange.ToStatus) *OrderStatusChangeUpdateOne {
+	if os != nil {
+		oscuo.SetToStatus(*os)
+	}
+	return oscuo
+}
+
+// SetActorID sets the "actor_id" field.
+func (oscuo *OrderStatusChangeUpdateOne) SetActorID(u uuid.UUID) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.SetActorID(u)
+	return oscuo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (oscuo *OrderStatusChangeUpdateOne) SetNillableActorID(u *uuid.UUID) *OrderStatusChangeUpdateOne {
+	if u != nil {
+		oscuo.SetActorID(*u)
+	}
+	return oscuo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (oscuo *OrderStatusChangeUpdateOne) ClearActorID() *OrderStatusChangeUpdateOne {
+	oscuo.mutation.ClearActorID()
+	return oscuo
+}
+
+// SetReason sets the "reason" field.
+func (oscuo *OrderStatusChangeUpdateOne) SetReason(s string) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.SetReason(s)
+	return oscuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (oscuo *OrderStatusChangeUpdateOne) SetNillableReason(s *string) *OrderStatusChangeUpdateOne {
+	if s != nil {
+		oscuo.SetReason(*s)
+	}
+	return oscuo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oscuo *OrderStatusChangeUpdateOne) SetOrder(o *Order) *OrderStatusChangeUpdateOne {
+	return oscuo.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusChangeMutation object of the builder.
+func (oscuo *OrderStatusChangeUpdateOne) Mutation() *OrderStatusChangeMutation {
+	return oscuo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oscuo *OrderStatusChangeUpdateOne) ClearOrder() *OrderStatusChangeUpdateOne {
+	oscuo.mutation.ClearOrder()
+	return oscuo
+}
+
+// Where appends a list predicates to the OrderStatusChangeUpdate builder.
+func (oscuo *OrderStatusChangeUpdateOne) Where(ps ...predicate.OrderStatusChange) *OrderStatusChangeUpdateOne {
+	oscuo.mutation.Where(ps...)
+	return oscuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oscuo *OrderStatusChangeUpdateOne) Select(field string, fields ...string) *OrderStatusChangeUpdateOne {
+	oscuo.fields = append([]string{field}, fields...)
+	return oscuo
+}
+
+// Save executes the query and returns the updated OrderStatusChange entity.
+func (oscuo *OrderStatusChangeUpdateOne) Save(ctx context.Context) (*OrderStatusChange, error) {
+	return withHooks(ctx, oscuo.sqlSave, oscuo.mutation, oscuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oscuo *OrderStatusChangeUpdateOne) SaveX(ctx context.Context) *OrderStatusChange {
+	node, err := oscuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oscuo *OrderStatusChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := oscuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oscuo *OrderStatusChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := oscuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oscuo *OrderStatusChangeUpdateOne) check() error {
+	if v, ok := oscuo.mutation.FromStatus(); ok {
+		if err := orderstatuschange.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.from_status": %w`, err)}
+		}
+	}
+	if v, ok := oscuo.mutation.ToStatus(); ok {
+		if err := orderstatuschange.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusChange.to_status": %w`, err)}
+		}
+	}
+	if oscuo.mutation.OrderCleared() && len(oscuo.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrderStatusChange.order"`)
+	}
+	return nil
+}
+
+func (oscuo *OrderStatusChangeUpdateOne) sqlSave(ctx context.Context) (_node *OrderStatusChange, err error) {
+	if err := oscuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatuschange.Table, orderstatuschange.Columns, sqlgraph.NewFieldSpec(orderstatuschange.FieldID, field.TypeUUID))
+	id, ok := oscuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderStatusChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oscuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatuschange.FieldID)
+		for _, f := range fields {
+			if !orderstatuschange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderstatuschange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oscuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oscuo.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldFromStatus, field.TypeEnum, value)
+	}
+	if oscuo.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatuschange.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := oscuo.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatuschange.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := oscuo.mutation.ActorID(); ok {
+		_spec.SetField(orderstatuschange.FieldActorID, field.TypeUUID, value)
+	}
+	if oscuo.mutation.ActorIDCleared() {
+		_spec.ClearField(orderstatuschange.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := oscuo.mutation.Reason(); ok {
+		_spec.SetField(orderstatuschange.FieldReason, field.TypeString, value)
+	}
+	if oscuo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatuschange.OrderTable,
+			Columns: []string{orderstatuschange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oscuo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatuschange.OrderTable,
+			Columns: []string{orderstatuschange.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrderStatusChange{config: oscuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oscuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatuschange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oscuo.mutation.done = true
+	return _node, nil
+}
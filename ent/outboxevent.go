@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is the model entity for the OutboxEvent schema.
+type OutboxEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ID заказа
+	OrderID uuid.UUID `json:"order_id,omitempty"`
+	// Тип события
+	Type outboxevent.Type `json:"type,omitempty"`
+	// Данные события
+	Payload jsontext.Value `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status outboxevent.Status `json:"status,omitempty"`
+	// Количество попыток публикации
+	Attempts int `json:"attempts,omitempty"`
+	// Ошибка последней попытки
+	LastError string `json:"last_error,omitempty"`
+	// Когда событие можно (повторно) отправить
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PublishedAt holds the value of the "published_at" field.
+	PublishedAt  *time.Time `json:"published_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldPayload:
+			values[i] = new([]byte)
+		case outboxevent.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case outboxevent.FieldType, outboxevent.FieldStatus, outboxevent.FieldLastError:
+			values[i] = new(sql.NullString)
+		case outboxevent.FieldNextAttemptAt, outboxevent.FieldCreatedAt, outboxevent.FieldPublishedAt:
+			values[i] = new(sql.NullTime)
+		case outboxevent.FieldID, outboxevent.FieldOrderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxEvent fields.
+func (oe *OutboxEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				oe.ID = *value
+			}
+		case outboxevent.FieldOrderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value != nil {
+				oe.OrderID = *value
+			}
+		case outboxevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				oe.Type = outboxevent.Type(value.String)
+			}
+		case outboxevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oe.Payload); err != nil {
+					return fmt.Errorf("unmarshal field payload: %w", err)
+				}
+			}
+		case outboxevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				oe.Status = outboxevent.Status(value.String)
+			}
+		case outboxevent.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				oe.Attempts = int(value.Int64)
+			}
+		case outboxevent.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				oe.LastError = value.String
+			}
+		case outboxevent.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				oe.NextAttemptAt = value.Time
+			}
+		case outboxevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oe.CreatedAt = value.Time
+			}
+		case outboxevent.FieldPublishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field published_at", values[i])
+			} else if value.Valid {
+				oe.PublishedAt = new(time.Time)
+				*oe.PublishedAt = value.Time
+			}
+		default:
+			oe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxEvent.
+// This includes values selected through modifiers, order, etc.
+func (oe *OutboxEvent) Value(name string) (ent.Value, error) {
+	return oe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxEvent.
+// Note that you need to call OutboxEvent.Unwrap() before calling this method if this OutboxEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OutboxEvent) Update() *OutboxEventUpdateOne {
+	return NewOutboxEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OutboxEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OutboxEvent) Unwrap() *OutboxEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OutboxEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", oe.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Type))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(oe.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(oe.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(oe.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := oe.PublishedAt; v != nil {
+		builder.WriteString("published_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxEvents is a parsable slice of OutboxEvent.
+type OutboxEvents []*OutboxEvent
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package outboxevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the outboxevent type in the database.
+	Label = "outbox_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldPayload holds the string denoting the payload field in the database.
+	FieldPayload = "payload"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldPublishedAt holds the string denoting the published_at field in the database.
+	FieldPublishedAt = "published_at"
+	// Table holds the table name of the outboxevent in the database.
+	Table = "outbox_events"
+)
+
+// Columns holds all SQL columns for outboxevent fields.
+var Columns = []string{
+	FieldID,
+	FieldOrderID,
+	FieldType,
+	FieldPayload,
+	FieldStatus,
+	FieldAttempts,
+	FieldLastError,
+	FieldNextAttemptAt,
+	FieldCreatedAt,
+	FieldPublishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultLastError holds the default value on creation for the "last_error" field.
+	DefaultLastError string
+	// DefaultNextAttemptAt holds the default value on creation for the "next_attempt_at" field.
+	DefaultNextAttemptAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeOrderCreated            Type = "order_created"
+	TypeOrderUpdated            Type = "order_updated"
+	TypeOrderAssigned           Type = "order_assigned"
+	TypeOrderStatusChanged      Type = "order_status_changed"
+	TypeOrderDeleted            Type = "order_deleted"
+	TypeOrderRestored           Type = "order_restored"
+	TypeOrderUnassigned         Type = "order_unassigned"
+	TypeMasterTransferRequested Type = "master_transfer_requested"
+	TypeMasterTransferDeclined  Type = "master_transfer_declined"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeOrderCreated, TypeOrderUpdated, TypeOrderAssigned, TypeOrderStatusChanged, TypeOrderDeleted, TypeOrderRestored, TypeOrderUnassigned, TypeMasterTransferRequested, TypeMasterTransferDeclined:
+		return nil
+	default:
+		return fmt.Errorf("outboxevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusPublished Status = "published"
+	StatusDead      Status = "dead"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusPublished, StatusDead:
+		return nil
+	default:
+		return fmt.Errorf("outboxevent: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the OutboxEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPublishedAt orders the results by the published_at field.
+func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetStatus sets the "status" field.
+func (oeu *OutboxEventUpdate) SetStatus(o outboxevent.Status) *OutboxEventUpdate {
+	oeu.mutation.SetStatus(o)
+	return oeu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableStatus(o *outboxevent.Status) *OutboxEventUpdate {
+	if o != nil {
+		oeu.SetStatus(*o)
+	}
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetLastError sets the "last_error" field.
+func (oeu *OutboxEventUpdate) SetLastError(s string) *OutboxEventUpdate {
+	oeu.mutation.SetLastError(s)
+	return oeu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableLastError(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetLastError(*s)
+	}
+	return oeu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeu *OutboxEventUpdate) SetNextAttemptAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetNextAttemptAt(t)
+	return oeu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetNextAttemptAt(*t)
+	}
+	return oeu
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeu *OutboxEventUpdate) SetPublishedAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetPublishedAt(t)
+	return oeu
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillablePublishedAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetPublishedAt(*t)
+	}
+	return oeu
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (oeu *OutboxEventUpdate) ClearPublishedAt() *OutboxEventUpdate {
+	oeu.mutation.ClearPublishedAt()
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeu *OutboxEventUpdate) check() error {
+	if v, ok := oeu.mutation.Status(); ok {
+		if err := outboxevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeUUID))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.Status(); ok {
+		_spec.SetField(outboxevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := oeu.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if oeu.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetStatus sets the "status" field.
+func (oeuo *OutboxEventUpdateOne) SetStatus(o outboxevent.Status) *OutboxEventUpdateOne {
+	oeuo.mutation.SetStatus(o)
+	return oeuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableStatus(o *outboxevent.Status) *OutboxEventUpdateOne {
+	if o != nil {
+		oeuo.SetStatus(*o)
+	}
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetLastError sets the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) SetLastError(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetLastError(s)
+	return oeuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableLastError(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetLastError(*s)
+	}
+	return oeuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeuo *OutboxEventUpdateOne) SetNextAttemptAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetNextAttemptAt(t)
+	return oeuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetNextAttemptAt(*t)
+	}
+	return oeuo
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) SetPublishedAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPublishedAt(t)
+	return oeuo
+}
+
+// SetNillablePublishedAt sets the "published_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillablePublishedAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetPublishedAt(*t)
+	}
+	return oeuo
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (oeuo *OutboxEventUpdateOne) ClearPublishedAt() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearPublishedAt()
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oeuo *OutboxEventUpdateOne) check() error {
+	if v, ok := oeuo.mutation.Status(); ok {
+		if err := outboxevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OutboxEvent.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	if err := oeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeUUID))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.Status(); ok {
+		_spec.SetField(outboxevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := oeuo.mutation.PublishedAt(); ok {
+		_spec.SetField(outboxevent.FieldPublishedAt, field.TypeTime, value)
+	}
+	if oeuo.mutation.PublishedAtCleared() {
+		_spec.ClearField(outboxevent.FieldPublishedAt, field.TypeTime)
+	}
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
@@ -2,7 +2,188 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/Ostap00034/course-work-backend-order-service/ent/runtime.go
+import (
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/schema"
+	"github.com/google/uuid"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = idempotencykeyDescKey.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[5].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() uuid.UUID)
+	mastertransferFields := schema.MasterTransfer{}.Fields()
+	_ = mastertransferFields
+	// mastertransferDescReason is the schema descriptor for reason field.
+	mastertransferDescReason := mastertransferFields[4].Descriptor()
+	// mastertransfer.DefaultReason holds the default value on creation for the reason field.
+	mastertransfer.DefaultReason = mastertransferDescReason.Default.(string)
+	// mastertransferDescCreatedAt is the schema descriptor for created_at field.
+	mastertransferDescCreatedAt := mastertransferFields[8].Descriptor()
+	// mastertransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mastertransfer.DefaultCreatedAt = mastertransferDescCreatedAt.Default.(func() time.Time)
+	// mastertransferDescUpdatedAt is the schema descriptor for updated_at field.
+	mastertransferDescUpdatedAt := mastertransferFields[9].Descriptor()
+	// mastertransfer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	mastertransfer.DefaultUpdatedAt = mastertransferDescUpdatedAt.Default.(func() time.Time)
+	// mastertransfer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	mastertransfer.UpdateDefaultUpdatedAt = mastertransferDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// mastertransferDescID is the schema descriptor for id field.
+	mastertransferDescID := mastertransferFields[0].Descriptor()
+	// mastertransfer.DefaultID holds the default value on creation for the id field.
+	mastertransfer.DefaultID = mastertransferDescID.Default.(func() uuid.UUID)
+	offerFields := schema.Offer{}.Fields()
+	_ = offerFields
+	// offerDescPrice is the schema descriptor for price field.
+	offerDescPrice := offerFields[3].Descriptor()
+	// offer.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	offer.PriceValidator = offerDescPrice.Validators[0].(func(float32) error)
+	// offerDescComment is the schema descriptor for comment field.
+	offerDescComment := offerFields[4].Descriptor()
+	// offer.DefaultComment holds the default value on creation for the comment field.
+	offer.DefaultComment = offerDescComment.Default.(string)
+	// offerDescCreatedAt is the schema descriptor for created_at field.
+	offerDescCreatedAt := offerFields[7].Descriptor()
+	// offer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	offer.DefaultCreatedAt = offerDescCreatedAt.Default.(func() time.Time)
+	// offerDescUpdatedAt is the schema descriptor for updated_at field.
+	offerDescUpdatedAt := offerFields[8].Descriptor()
+	// offer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	offer.DefaultUpdatedAt = offerDescUpdatedAt.Default.(func() time.Time)
+	// offer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	offer.UpdateDefaultUpdatedAt = offerDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// offerDescID is the schema descriptor for id field.
+	offerDescID := offerFields[0].Descriptor()
+	// offer.DefaultID holds the default value on creation for the id field.
+	offer.DefaultID = offerDescID.Default.(func() uuid.UUID)
+	orderHooks := schema.Order{}.Hooks()
+	order.Hooks[0] = orderHooks[0]
+	orderFields := schema.Order{}.Fields()
+	_ = orderFields
+	// orderDescTitle is the schema descriptor for title field.
+	orderDescTitle := orderFields[1].Descriptor()
+	// order.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	order.TitleValidator = orderDescTitle.Validators[0].(func(string) error)
+	// orderDescPrice is the schema descriptor for price field.
+	orderDescPrice := orderFields[3].Descriptor()
+	// order.DefaultPrice holds the default value on creation for the price field.
+	order.DefaultPrice = orderDescPrice.Default.(float32)
+	// orderDescAddress is the schema descriptor for address field.
+	orderDescAddress := orderFields[4].Descriptor()
+	// order.AddressValidator is a validator for the "address" field. It is called by the builders before save.
+	order.AddressValidator = orderDescAddress.Validators[0].(func(string) error)
+	// orderDescLongitude is the schema descriptor for longitude field.
+	orderDescLongitude := orderFields[5].Descriptor()
+	// order.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	order.LongitudeValidator = func() func(float64) error {
+		validators := orderDescLongitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(longitude float64) error {
+			for _, fn := range fns {
+				if err := fn(longitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderDescLatitude is the schema descriptor for latitude field.
+	orderDescLatitude := orderFields[6].Descriptor()
+	// order.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	order.LatitudeValidator = func() func(float64) error {
+		validators := orderDescLatitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(latitude float64) error {
+			for _, fn := range fns {
+				if err := fn(latitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderDescVersion is the schema descriptor for version field.
+	orderDescVersion := orderFields[11].Descriptor()
+	// order.DefaultVersion holds the default value on creation for the version field.
+	order.DefaultVersion = orderDescVersion.Default.(int)
+	// orderDescCreatedAt is the schema descriptor for created_at field.
+	orderDescCreatedAt := orderFields[12].Descriptor()
+	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
+	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
+	// orderDescUpdatedAt is the schema descriptor for updated_at field.
+	orderDescUpdatedAt := orderFields[13].Descriptor()
+	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
+	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderDescID is the schema descriptor for id field.
+	orderDescID := orderFields[0].Descriptor()
+	// order.DefaultID holds the default value on creation for the id field.
+	order.DefaultID = orderDescID.Default.(func() uuid.UUID)
+	orderstatuschangeFields := schema.OrderStatusChange{}.Fields()
+	_ = orderstatuschangeFields
+	// orderstatuschangeDescReason is the schema descriptor for reason field.
+	orderstatuschangeDescReason := orderstatuschangeFields[5].Descriptor()
+	// orderstatuschange.DefaultReason holds the default value on creation for the reason field.
+	orderstatuschange.DefaultReason = orderstatuschangeDescReason.Default.(string)
+	// orderstatuschangeDescCreatedAt is the schema descriptor for created_at field.
+	orderstatuschangeDescCreatedAt := orderstatuschangeFields[6].Descriptor()
+	// orderstatuschange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderstatuschange.DefaultCreatedAt = orderstatuschangeDescCreatedAt.Default.(func() time.Time)
+	// orderstatuschangeDescID is the schema descriptor for id field.
+	orderstatuschangeDescID := orderstatuschangeFields[0].Descriptor()
+	// orderstatuschange.DefaultID holds the default value on creation for the id field.
+	orderstatuschange.DefaultID = orderstatuschangeDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[6].Descriptor()
+	// outboxevent.DefaultLastError holds the default value on creation for the last_error field.
+	outboxevent.DefaultLastError = outboxeventDescLastError.Default.(string)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[8].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventFields[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.4"                                         // Version of ent codegen.
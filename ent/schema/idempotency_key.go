@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey — ключ идемпотентности запроса на создание заказа.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("key").NotEmpty().Immutable().Comment("Ключ, переданный клиентом"),
+		field.UUID("client_id", uuid.UUID{}).Immutable().Comment("ID клиента, создавшего заказ"),
+		field.String("fingerprint").Immutable().Comment("Отпечаток тела запроса"),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("ID созданного заказа"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (IdempotencyKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("idempotency_keys").
+			Field("order_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		// Ключи уникальны в пределах клиента
+		index.Fields("client_id", "key").Unique(),
+	}
+}
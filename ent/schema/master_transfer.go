@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MasterTransfer — передача заказа в работе от одного исполнителя другому.
+// Вступает в силу, когда новый исполнитель её принимает.
+type MasterTransfer struct {
+	ent.Schema
+}
+
+func (MasterTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("order_id", uuid.UUID{}).Comment("ID заказа"),
+		field.UUID("from_master_id", uuid.UUID{}).Immutable().Comment("ID исполнителя, передающего заказ"),
+		field.UUID("to_master_id", uuid.UUID{}).Immutable().Comment("ID исполнителя, которому передаётся заказ"),
+		field.String("reason").Default("").Immutable().Comment("Причина передачи"),
+		field.Enum("status").Values("pending", "accepted", "declined", "cancelled").Default("pending"),
+		field.UUID("requested_by", uuid.UUID{}).Immutable().Comment("ID пользователя, запросившего передачу"),
+		field.UUID("decided_by", uuid.UUID{}).Optional().Comment("ID пользователя, принявшего или отклонившего передачу"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (MasterTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("transfers").
+			Field("order_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (MasterTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		// По заказу может ожидать ответа только одна передача
+		index.Fields("order_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'pending'")),
+		index.Fields("to_master_id", "status"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Offer — предложение исполнителя по заказу.
+type Offer struct {
+	ent.Schema
+}
+
+func (Offer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("order_id", uuid.UUID{}).Comment("ID заказа"),
+		field.UUID("master_id", uuid.UUID{}).Comment("ID исполнителя"),
+		field.Float32("price").Min(0).Comment("Предложенная цена"),
+		field.String("comment").Default("").Comment("Комментарий исполнителя"),
+		field.Time("estimated_at").Optional().Nillable().Comment("Ожидаемая дата выполнения"),
+		field.Enum("status").Values("pending", "accepted", "rejected").Default("pending"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+func (Offer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("offers").
+			Field("order_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Offer) Indexes() []ent.Index {
+	return []ent.Index{
+		// Один исполнитель — одно предложение на заказ
+		index.Fields("order_id", "master_id").Unique(),
+	}
+}
@@ -1,11 +1,18 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	gen "github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/hook"
 )
 
 // Order — модель заказа.
@@ -20,24 +27,60 @@ func (Order) Fields() []ent.Field {
 			Unique(),
 		field.String("title").NotEmpty().Comment("Название"),
 		field.String("description").
-			NotEmpty().
-			Comment("Описание заказа"),
+			Comment("Описание заказа, может быть пустым"),
 		field.Float32("price").Default(0).Comment("Цена"),
 		field.String("address").NotEmpty().Comment("Адрес заказа"),
-		field.String("longitude").NotEmpty().Comment("Долгота"),
-		field.String("latitude").NotEmpty().Comment("Широта"),
+		field.Float("longitude").Min(-180).Max(180).Comment("Долгота"),
+		field.Float("latitude").Min(-90).Max(90).Comment("Широта"),
 		field.UUID("category_id", uuid.UUID{}).Comment("ID категории"),
 		field.UUID("client_id", uuid.UUID{}).Comment("ID автора"),
 		field.UUID("master_id", uuid.UUID{}).Optional().Comment("ID исполнителя"),
 		field.Enum("status").Values("active", "in_progress", "cancel", "done").Default("active"),
+		field.Int("version").Default(1).Comment("Версия для оптимистичной блокировки, растёт при каждом изменении"),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Время мягкого удаления"),
 	}
 }
 
 func (Order) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("status_changes", OrderStatusChange.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("История изменения статуса"),
+		edge.To("offers", Offer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Предложения исполнителей"),
+		edge.To("transfers", MasterTransfer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Передачи заказа между исполнителями"),
+		edge.To("idempotency_keys", IdempotencyKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Ключи идемпотентности запросов на создание"),
+	}
+}
+
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("latitude", "longitude"),
+		index.Fields("deleted_at"),
+	}
+}
+
+func (Order) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Увеличиваем версию заказа при каждом изменении
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.OrderFunc(func(ctx context.Context, m *gen.OrderMutation) (ent.Value, error) {
+				m.AddVersion(1)
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpUpdate|ent.OpUpdateOne),
+	}
 }
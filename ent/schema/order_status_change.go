@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrderStatusChange — запись истории изменения статуса заказа.
+type OrderStatusChange struct {
+	ent.Schema
+}
+
+func (OrderStatusChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("order_id", uuid.UUID{}).Comment("ID заказа"),
+		field.Enum("from_status").
+			Values("active", "in_progress", "cancel", "done").
+			Optional().
+			Nillable().
+			Comment("Предыдущий статус, пусто при создании заказа"),
+		field.Enum("to_status").Values("active", "in_progress", "cancel", "done").Comment("Новый статус"),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Comment("ID пользователя, изменившего статус"),
+		field.String("reason").Default("").Comment("Причина изменения"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (OrderStatusChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("status_changes").
+			Field("order_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (OrderStatusChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id", "created_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OutboxEvent — доменное событие заказа, ожидающее публикации (transactional outbox).
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("order_id", uuid.UUID{}).Immutable().Comment("ID заказа"),
+		field.Enum("type").
+			Values("order_created", "order_updated", "order_assigned", "order_status_changed", "order_deleted", "order_restored",
+				"order_unassigned", "master_transfer_requested", "master_transfer_declined").
+			Immutable().
+			Comment("Тип события"),
+		field.JSON("payload", json.RawMessage{}).Immutable().Comment("Данные события"),
+		field.Enum("status").Values("pending", "published", "dead").Default("pending"),
+		field.Int("attempts").Default(0).Comment("Количество попыток публикации"),
+		field.String("last_error").Default("").Comment("Ошибка последней попытки"),
+		field.Time("next_attempt_at").
+			Default(time.Now).
+			Comment("Когда событие можно (повторно) отправить"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("published_at").Optional().Nillable(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -0,0 +1,639 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: orderext/v1/admin.proto
+
+package orderextv1
+
+import (
+	v1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ForceOrderStatusRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Обязательная причина, попадает в историю статусов
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceOrderStatusRequest) Reset() {
+	*x = ForceOrderStatusRequest{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceOrderStatusRequest) ProtoMessage() {}
+
+func (x *ForceOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*ForceOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForceOrderStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ForceOrderStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ForceOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ForceOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceOrderStatusResponse) Reset() {
+	*x = ForceOrderStatusResponse{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceOrderStatusResponse) ProtoMessage() {}
+
+func (x *ForceOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*ForceOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ForceOrderStatusResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ReassignMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasterId      string                 `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignMasterRequest) Reset() {
+	*x = ReassignMasterRequest{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignMasterRequest) ProtoMessage() {}
+
+func (x *ReassignMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignMasterRequest.ProtoReflect.Descriptor instead.
+func (*ReassignMasterRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReassignMasterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReassignMasterRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *ReassignMasterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReassignMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignMasterResponse) Reset() {
+	*x = ReassignMasterResponse{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignMasterResponse) ProtoMessage() {}
+
+func (x *ReassignMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignMasterResponse.ProtoReflect.Descriptor instead.
+func (*ReassignMasterResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReassignMasterResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type HardDeleteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardDeleteOrderRequest) Reset() {
+	*x = HardDeleteOrderRequest{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardDeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardDeleteOrderRequest) ProtoMessage() {}
+
+func (x *HardDeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardDeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*HardDeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HardDeleteOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HardDeleteOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type HardDeleteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardDeleteOrderResponse) Reset() {
+	*x = HardDeleteOrderResponse{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardDeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardDeleteOrderResponse) ProtoMessage() {}
+
+func (x *HardDeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardDeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*HardDeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+type ListAllOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	MasterId      string                 `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	CategoriesIds []string               `protobuf:"bytes,3,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	// Полнотекстовый запрос по названию, описанию и адресу
+	Query          string `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,6,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	// Границы даты создания, RFC 3339
+	CreatedAfter  string `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore string `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Границы цены; 0 — без ограничения
+	MinPrice  float32 `protobuf:"fixed32,9,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice  float32 `protobuf:"fixed32,10,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	PageSize  int32   `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string  `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// newest, oldest, price_asc, price_desc
+	Sort          string `protobuf:"bytes,13,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllOrdersRequest) Reset() {
+	*x = ListAllOrdersRequest{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllOrdersRequest) ProtoMessage() {}
+
+func (x *ListAllOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListAllOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListAllOrdersRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *ListAllOrdersRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListAllOrdersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListAllOrdersRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetMinPrice() float32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListAllOrdersRequest) GetMaxPrice() float32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListAllOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAllOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAllOrdersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListAllOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*v1.OrderData        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllOrdersResponse) Reset() {
+	*x = ListAllOrdersResponse{}
+	mi := &file_orderext_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllOrdersResponse) ProtoMessage() {}
+
+func (x *ListAllOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListAllOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListAllOrdersResponse) GetOrders() []*v1.OrderData {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListAllOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAllOrdersResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+var File_orderext_v1_admin_proto protoreflect.FileDescriptor
+
+const file_orderext_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x17orderext/v1/admin.proto\x12\vorderext.v1\x1a\x16common/v1/common.proto\"Y\n" +
+	"\x17ForceOrderStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"F\n" +
+	"\x18ForceOrderStatusResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"\\\n" +
+	"\x15ReassignMasterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmaster_id\x18\x02 \x01(\tR\bmasterId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"D\n" +
+	"\x16ReassignMasterResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"@\n" +
+	"\x16HardDeleteOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x19\n" +
+	"\x17HardDeleteOrderResponse\"\xa8\x03\n" +
+	"\x14ListAllOrdersRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tmaster_id\x18\x02 \x01(\tR\bmasterId\x12%\n" +
+	"\x0ecategories_ids\x18\x03 \x03(\tR\rcategoriesIds\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12'\n" +
+	"\x0finclude_deleted\x18\x06 \x01(\bR\x0eincludeDeleted\x12#\n" +
+	"\rcreated_after\x18\a \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\b \x01(\tR\rcreatedBefore\x12\x1b\n" +
+	"\tmin_price\x18\t \x01(\x02R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\n" +
+	" \x01(\x02R\bmaxPrice\x12\x1b\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\f \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\r \x01(\tR\x04sort\"\x8e\x01\n" +
+	"\x15ListAllOrdersResponse\x12,\n" +
+	"\x06orders\x18\x01 \x03(\v2\x14.common.v1.OrderDataR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount2\x85\x03\n" +
+	"\x11OrderAdminService\x12_\n" +
+	"\x10ForceOrderStatus\x12$.orderext.v1.ForceOrderStatusRequest\x1a%.orderext.v1.ForceOrderStatusResponse\x12Y\n" +
+	"\x0eReassignMaster\x12\".orderext.v1.ReassignMasterRequest\x1a#.orderext.v1.ReassignMasterResponse\x12\\\n" +
+	"\x0fHardDeleteOrder\x12#.orderext.v1.HardDeleteOrderRequest\x1a$.orderext.v1.HardDeleteOrderResponse\x12V\n" +
+	"\rListAllOrders\x12!.orderext.v1.ListAllOrdersRequest\x1a\".orderext.v1.ListAllOrdersResponseBWZUgithub.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1;orderextv1b\x06proto3"
+
+var (
+	file_orderext_v1_admin_proto_rawDescOnce sync.Once
+	file_orderext_v1_admin_proto_rawDescData []byte
+)
+
+func file_orderext_v1_admin_proto_rawDescGZIP() []byte {
+	file_orderext_v1_admin_proto_rawDescOnce.Do(func() {
+		file_orderext_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_orderext_v1_admin_proto_rawDesc), len(file_orderext_v1_admin_proto_rawDesc)))
+	})
+	return file_orderext_v1_admin_proto_rawDescData
+}
+
+var file_orderext_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_orderext_v1_admin_proto_goTypes = []any{
+	(*ForceOrderStatusRequest)(nil),  // 0: orderext.v1.ForceOrderStatusRequest
+	(*ForceOrderStatusResponse)(nil), // 1: orderext.v1.ForceOrderStatusResponse
+	(*ReassignMasterRequest)(nil),    // 2: orderext.v1.ReassignMasterRequest
+	(*ReassignMasterResponse)(nil),   // 3: orderext.v1.ReassignMasterResponse
+	(*HardDeleteOrderRequest)(nil),   // 4: orderext.v1.HardDeleteOrderRequest
+	(*HardDeleteOrderResponse)(nil),  // 5: orderext.v1.HardDeleteOrderResponse
+	(*ListAllOrdersRequest)(nil),     // 6: orderext.v1.ListAllOrdersRequest
+	(*ListAllOrdersResponse)(nil),    // 7: orderext.v1.ListAllOrdersResponse
+	(*v1.OrderData)(nil),             // 8: common.v1.OrderData
+}
+var file_orderext_v1_admin_proto_depIdxs = []int32{
+	8, // 0: orderext.v1.ForceOrderStatusResponse.order:type_name -> common.v1.OrderData
+	8, // 1: orderext.v1.ReassignMasterResponse.order:type_name -> common.v1.OrderData
+	8, // 2: orderext.v1.ListAllOrdersResponse.orders:type_name -> common.v1.OrderData
+	0, // 3: orderext.v1.OrderAdminService.ForceOrderStatus:input_type -> orderext.v1.ForceOrderStatusRequest
+	2, // 4: orderext.v1.OrderAdminService.ReassignMaster:input_type -> orderext.v1.ReassignMasterRequest
+	4, // 5: orderext.v1.OrderAdminService.HardDeleteOrder:input_type -> orderext.v1.HardDeleteOrderRequest
+	6, // 6: orderext.v1.OrderAdminService.ListAllOrders:input_type -> orderext.v1.ListAllOrdersRequest
+	1, // 7: orderext.v1.OrderAdminService.ForceOrderStatus:output_type -> orderext.v1.ForceOrderStatusResponse
+	3, // 8: orderext.v1.OrderAdminService.ReassignMaster:output_type -> orderext.v1.ReassignMasterResponse
+	5, // 9: orderext.v1.OrderAdminService.HardDeleteOrder:output_type -> orderext.v1.HardDeleteOrderResponse
+	7, // 10: orderext.v1.OrderAdminService.ListAllOrders:output_type -> orderext.v1.ListAllOrdersResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_orderext_v1_admin_proto_init() }
+func file_orderext_v1_admin_proto_init() {
+	if File_orderext_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orderext_v1_admin_proto_rawDesc), len(file_orderext_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_orderext_v1_admin_proto_goTypes,
+		DependencyIndexes: file_orderext_v1_admin_proto_depIdxs,
+		MessageInfos:      file_orderext_v1_admin_proto_msgTypes,
+	}.Build()
+	File_orderext_v1_admin_proto = out.File
+	file_orderext_v1_admin_proto_goTypes = nil
+	file_orderext_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: orderext/v1/admin.proto
+
+package orderextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrderAdminService_ForceOrderStatus_FullMethodName = "/orderext.v1.OrderAdminService/ForceOrderStatus"
+	OrderAdminService_ReassignMaster_FullMethodName   = "/orderext.v1.OrderAdminService/ReassignMaster"
+	OrderAdminService_HardDeleteOrder_FullMethodName  = "/orderext.v1.OrderAdminService/HardDeleteOrder"
+	OrderAdminService_ListAllOrders_FullMethodName    = "/orderext.v1.OrderAdminService/ListAllOrders"
+)
+
+// OrderAdminServiceClient is the client API for OrderAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Операции поддержки над любыми заказами. По умолчанию доступны только
+// администраторам; роли задаются политикой доступа в конфигурации.
+type OrderAdminServiceClient interface {
+	// Установить статус заказа в обход таблицы переходов
+	ForceOrderStatus(ctx context.Context, in *ForceOrderStatusRequest, opts ...grpc.CallOption) (*ForceOrderStatusResponse, error)
+	// Назначить на заказ другого исполнителя
+	ReassignMaster(ctx context.Context, in *ReassignMasterRequest, opts ...grpc.CallOption) (*ReassignMasterResponse, error)
+	// Окончательно удалить заказ вместе с историей и предложениями
+	HardDeleteOrder(ctx context.Context, in *HardDeleteOrderRequest, opts ...grpc.CallOption) (*HardDeleteOrderResponse, error)
+	// Заказы всех пользователей с произвольными фильтрами
+	ListAllOrders(ctx context.Context, in *ListAllOrdersRequest, opts ...grpc.CallOption) (*ListAllOrdersResponse, error)
+}
+
+type orderAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderAdminServiceClient(cc grpc.ClientConnInterface) OrderAdminServiceClient {
+	return &orderAdminServiceClient{cc}
+}
+
+func (c *orderAdminServiceClient) ForceOrderStatus(ctx context.Context, in *ForceOrderStatusRequest, opts ...grpc.CallOption) (*ForceOrderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceOrderStatusResponse)
+	err := c.cc.Invoke(ctx, OrderAdminService_ForceOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderAdminServiceClient) ReassignMaster(ctx context.Context, in *ReassignMasterRequest, opts ...grpc.CallOption) (*ReassignMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignMasterResponse)
+	err := c.cc.Invoke(ctx, OrderAdminService_ReassignMaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderAdminServiceClient) HardDeleteOrder(ctx context.Context, in *HardDeleteOrderRequest, opts ...grpc.CallOption) (*HardDeleteOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HardDeleteOrderResponse)
+	err := c.cc.Invoke(ctx, OrderAdminService_HardDeleteOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderAdminServiceClient) ListAllOrders(ctx context.Context, in *ListAllOrdersRequest, opts ...grpc.CallOption) (*ListAllOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderAdminService_ListAllOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderAdminServiceServer is the server API for OrderAdminService service.
+// All implementations must embed UnimplementedOrderAdminServiceServer
+// for forward compatibility.
+//
+// Операции поддержки над любыми заказами. По умолчанию доступны только
+// администраторам; роли задаются политикой доступа в конфигурации.
+type OrderAdminServiceServer interface {
+	// Установить статус заказа в обход таблицы переходов
+	ForceOrderStatus(context.Context, *ForceOrderStatusRequest) (*ForceOrderStatusResponse, error)
+	// Назначить на заказ другого исполнителя
+	ReassignMaster(context.Context, *ReassignMasterRequest) (*ReassignMasterResponse, error)
+	// Окончательно удалить заказ вместе с историей и предложениями
+	HardDeleteOrder(context.Context, *HardDeleteOrderRequest) (*HardDeleteOrderResponse, error)
+	// Заказы всех пользователей с произвольными фильтрами
+	ListAllOrders(context.Context, *ListAllOrdersRequest) (*ListAllOrdersResponse, error)
+	mustEmbedUnimplementedOrderAdminServiceServer()
+}
+
+// UnimplementedOrderAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderAdminServiceServer struct{}
+
+func (UnimplementedOrderAdminServiceServer) ForceOrderStatus(context.Context, *ForceOrderStatusRequest) (*ForceOrderStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ForceOrderStatus not implemented")
+}
+func (UnimplementedOrderAdminServiceServer) ReassignMaster(context.Context, *ReassignMasterRequest) (*ReassignMasterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignMaster not implemented")
+}
+func (UnimplementedOrderAdminServiceServer) HardDeleteOrder(context.Context, *HardDeleteOrderRequest) (*HardDeleteOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method HardDeleteOrder not implemented")
+}
+func (UnimplementedOrderAdminServiceServer) ListAllOrders(context.Context, *ListAllOrdersRequest) (*ListAllOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllOrders not implemented")
+}
+func (UnimplementedOrderAdminServiceServer) mustEmbedUnimplementedOrderAdminServiceServer() {}
+func (UnimplementedOrderAdminServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeOrderAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderAdminServiceServer will
+// result in compilation errors.
+type UnsafeOrderAdminServiceServer interface {
+	mustEmbedUnimplementedOrderAdminServiceServer()
+}
+
+func RegisterOrderAdminServiceServer(s grpc.ServiceRegistrar, srv OrderAdminServiceServer) {
+	// If the following call panics, it indicates UnimplementedOrderAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderAdminService_ServiceDesc, srv)
+}
+
+func _OrderAdminService_ForceOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAdminServiceServer).ForceOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAdminService_ForceOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAdminServiceServer).ForceOrderStatus(ctx, req.(*ForceOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderAdminService_ReassignMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAdminServiceServer).ReassignMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAdminService_ReassignMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAdminServiceServer).ReassignMaster(ctx, req.(*ReassignMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderAdminService_HardDeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HardDeleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAdminServiceServer).HardDeleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAdminService_HardDeleteOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAdminServiceServer).HardDeleteOrder(ctx, req.(*HardDeleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderAdminService_ListAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderAdminServiceServer).ListAllOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderAdminService_ListAllOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderAdminServiceServer).ListAllOrders(ctx, req.(*ListAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrderAdminService_ServiceDesc is the grpc.ServiceDesc for OrderAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orderext.v1.OrderAdminService",
+	HandlerType: (*OrderAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ForceOrderStatus",
+			Handler:    _OrderAdminService_ForceOrderStatus_Handler,
+		},
+		{
+			MethodName: "ReassignMaster",
+			Handler:    _OrderAdminService_ReassignMaster_Handler,
+		},
+		{
+			MethodName: "HardDeleteOrder",
+			Handler:    _OrderAdminService_HardDeleteOrder_Handler,
+		},
+		{
+			MethodName: "ListAllOrders",
+			Handler:    _OrderAdminService_ListAllOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "orderext/v1/admin.proto",
+}
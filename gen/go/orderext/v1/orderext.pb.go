@@ -0,0 +1,2119 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: orderext/v1/orderext.proto
+
+package orderextv1
+
+import (
+	v1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Запись истории изменения статуса заказа
+type OrderStatusChangeData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChangeData) Reset() {
+	*x = OrderStatusChangeData{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChangeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChangeData) ProtoMessage() {}
+
+func (x *OrderStatusChangeData) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChangeData.ProtoReflect.Descriptor instead.
+func (*OrderStatusChangeData) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderStatusChangeData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusChangeData) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetOrderTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineRequest) Reset() {
+	*x = GetOrderTimelineRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineRequest) ProtoMessage() {}
+
+func (x *GetOrderTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetOrderTimelineRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderTimelineResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Changes       []*OrderStatusChangeData `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineResponse) Reset() {
+	*x = GetOrderTimelineResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineResponse) ProtoMessage() {}
+
+func (x *GetOrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOrderTimelineResponse) GetChanges() []*OrderStatusChangeData {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetNearbyOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Longitude     float64                `protobuf:"fixed64,1,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	RadiusMeters  float64                `protobuf:"fixed64,3,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	CategoriesIds []string               `protobuf:"bytes,4,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyOrdersRequest) Reset() {
+	*x = GetNearbyOrdersRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyOrdersRequest) ProtoMessage() {}
+
+func (x *GetNearbyOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetNearbyOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNearbyOrdersRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetNearbyOrdersRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNearbyOrdersRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *GetNearbyOrdersRequest) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *GetNearbyOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Заказ с расстоянием до точки поиска
+type NearbyOrderData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Order          *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NearbyOrderData) Reset() {
+	*x = NearbyOrderData{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyOrderData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyOrderData) ProtoMessage() {}
+
+func (x *NearbyOrderData) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyOrderData.ProtoReflect.Descriptor instead.
+func (*NearbyOrderData) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NearbyOrderData) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *NearbyOrderData) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+type GetNearbyOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*NearbyOrderData     `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearbyOrdersResponse) Reset() {
+	*x = GetNearbyOrdersResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearbyOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearbyOrdersResponse) ProtoMessage() {}
+
+func (x *GetNearbyOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearbyOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetNearbyOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetNearbyOrdersResponse) GetOrders() []*NearbyOrderData {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type SearchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoriesIds []string               `protobuf:"bytes,2,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersRequest) Reset() {
+	*x = SearchOrdersRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersRequest) ProtoMessage() {}
+
+func (x *SearchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*SearchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchOrdersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *SearchOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchOrdersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*v1.OrderData        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOrdersResponse) Reset() {
+	*x = SearchOrdersResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOrdersResponse) ProtoMessage() {}
+
+func (x *SearchOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOrdersResponse.ProtoReflect.Descriptor instead.
+func (*SearchOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchOrdersResponse) GetOrders() []*v1.OrderData {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// Предложение исполнителя по заказу
+type OfferData struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId  string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MasterId string                 `protobuf:"bytes,3,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Price    float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	Comment  string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// RFC 3339, пусто если не указано
+	EstimatedAt   string `protobuf:"bytes,6,opt,name=estimated_at,json=estimatedAt,proto3" json:"estimated_at,omitempty"`
+	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfferData) Reset() {
+	*x = OfferData{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfferData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferData) ProtoMessage() {}
+
+func (x *OfferData) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferData.ProtoReflect.Descriptor instead.
+func (*OfferData) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OfferData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OfferData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OfferData) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *OfferData) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OfferData) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *OfferData) GetEstimatedAt() string {
+	if x != nil {
+		return x.EstimatedAt
+	}
+	return ""
+}
+
+func (x *OfferData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OfferData) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *OfferData) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateOfferRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	OrderId  string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MasterId string                 `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Price    float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Comment  string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	// RFC 3339
+	EstimatedAt   string `protobuf:"bytes,5,opt,name=estimated_at,json=estimatedAt,proto3" json:"estimated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOfferRequest) Reset() {
+	*x = CreateOfferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferRequest) ProtoMessage() {}
+
+func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
+func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateOfferRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetEstimatedAt() string {
+	if x != nil {
+		return x.EstimatedAt
+	}
+	return ""
+}
+
+type CreateOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *OfferData             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOfferResponse) Reset() {
+	*x = CreateOfferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferResponse) ProtoMessage() {}
+
+func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferResponse.ProtoReflect.Descriptor instead.
+func (*CreateOfferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateOfferResponse) GetOffer() *OfferData {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type GetOrderOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderOffersRequest) Reset() {
+	*x = GetOrderOffersRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderOffersRequest) ProtoMessage() {}
+
+func (x *GetOrderOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderOffersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderOffersRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrderOffersRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderOffersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offers        []*OfferData           `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderOffersResponse) Reset() {
+	*x = GetOrderOffersResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderOffersResponse) ProtoMessage() {}
+
+func (x *GetOrderOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderOffersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderOffersResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetOrderOffersResponse) GetOffers() []*OfferData {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type AcceptOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOfferRequest) Reset() {
+	*x = AcceptOfferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferRequest) ProtoMessage() {}
+
+func (x *AcceptOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptOfferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AcceptOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+type AcceptOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptOfferResponse) Reset() {
+	*x = AcceptOfferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOfferResponse) ProtoMessage() {}
+
+func (x *AcceptOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOfferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AcceptOfferResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type RejectOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOfferRequest) Reset() {
+	*x = RejectOfferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOfferRequest) ProtoMessage() {}
+
+func (x *RejectOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOfferRequest.ProtoReflect.Descriptor instead.
+func (*RejectOfferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RejectOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+type RejectOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *OfferData             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectOfferResponse) Reset() {
+	*x = RejectOfferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectOfferResponse) ProtoMessage() {}
+
+func (x *RejectOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectOfferResponse.ProtoReflect.Descriptor instead.
+func (*RejectOfferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RejectOfferResponse) GetOffer() *OfferData {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type RestoreOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreOrderRequest) Reset() {
+	*x = RestoreOrderRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOrderRequest) ProtoMessage() {}
+
+func (x *RestoreOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOrderRequest.ProtoReflect.Descriptor instead.
+func (*RestoreOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreOrderResponse) Reset() {
+	*x = RestoreOrderResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOrderResponse) ProtoMessage() {}
+
+func (x *RestoreOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOrderResponse.ProtoReflect.Descriptor instead.
+func (*RestoreOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreOrderResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type UnassignMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignMasterRequest) Reset() {
+	*x = UnassignMasterRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignMasterRequest) ProtoMessage() {}
+
+func (x *UnassignMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignMasterRequest.ProtoReflect.Descriptor instead.
+func (*UnassignMasterRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnassignMasterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnassignMasterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnassignMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignMasterResponse) Reset() {
+	*x = UnassignMasterResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignMasterResponse) ProtoMessage() {}
+
+func (x *UnassignMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignMasterResponse.ProtoReflect.Descriptor instead.
+func (*UnassignMasterResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnassignMasterResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ReassignOrderMasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MasterId      string                 `protobuf:"bytes,2,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignOrderMasterRequest) Reset() {
+	*x = ReassignOrderMasterRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignOrderMasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignOrderMasterRequest) ProtoMessage() {}
+
+func (x *ReassignOrderMasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignOrderMasterRequest.ProtoReflect.Descriptor instead.
+func (*ReassignOrderMasterRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReassignOrderMasterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReassignOrderMasterRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+func (x *ReassignOrderMasterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReassignOrderMasterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignOrderMasterResponse) Reset() {
+	*x = ReassignOrderMasterResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignOrderMasterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignOrderMasterResponse) ProtoMessage() {}
+
+func (x *ReassignOrderMasterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignOrderMasterResponse.ProtoReflect.Descriptor instead.
+func (*ReassignOrderMasterResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReassignOrderMasterResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Передача заказа от одного исполнителя другому
+type MasterTransferData struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId      string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	FromMasterId string                 `protobuf:"bytes,3,opt,name=from_master_id,json=fromMasterId,proto3" json:"from_master_id,omitempty"`
+	ToMasterId   string                 `protobuf:"bytes,4,opt,name=to_master_id,json=toMasterId,proto3" json:"to_master_id,omitempty"`
+	Reason       string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// pending, accepted, declined, cancelled
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy   string `protobuf:"bytes,7,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	DecidedBy     string `protobuf:"bytes,8,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasterTransferData) Reset() {
+	*x = MasterTransferData{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasterTransferData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasterTransferData) ProtoMessage() {}
+
+func (x *MasterTransferData) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasterTransferData.ProtoReflect.Descriptor instead.
+func (*MasterTransferData) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MasterTransferData) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetFromMasterId() string {
+	if x != nil {
+		return x.FromMasterId
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetToMasterId() string {
+	if x != nil {
+		return x.ToMasterId
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MasterTransferData) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type RequestMasterTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ToMasterId    string                 `protobuf:"bytes,2,opt,name=to_master_id,json=toMasterId,proto3" json:"to_master_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMasterTransferRequest) Reset() {
+	*x = RequestMasterTransferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMasterTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMasterTransferRequest) ProtoMessage() {}
+
+func (x *RequestMasterTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMasterTransferRequest.ProtoReflect.Descriptor instead.
+func (*RequestMasterTransferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RequestMasterTransferRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RequestMasterTransferRequest) GetToMasterId() string {
+	if x != nil {
+		return x.ToMasterId
+	}
+	return ""
+}
+
+func (x *RequestMasterTransferRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestMasterTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *MasterTransferData    `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMasterTransferResponse) Reset() {
+	*x = RequestMasterTransferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMasterTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMasterTransferResponse) ProtoMessage() {}
+
+func (x *RequestMasterTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMasterTransferResponse.ProtoReflect.Descriptor instead.
+func (*RequestMasterTransferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RequestMasterTransferResponse) GetTransfer() *MasterTransferData {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptMasterTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptMasterTransferRequest) Reset() {
+	*x = AcceptMasterTransferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptMasterTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMasterTransferRequest) ProtoMessage() {}
+
+func (x *AcceptMasterTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMasterTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptMasterTransferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AcceptMasterTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type AcceptMasterTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v1.OrderData          `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptMasterTransferResponse) Reset() {
+	*x = AcceptMasterTransferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptMasterTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMasterTransferResponse) ProtoMessage() {}
+
+func (x *AcceptMasterTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMasterTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptMasterTransferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AcceptMasterTransferResponse) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type DeclineMasterTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineMasterTransferRequest) Reset() {
+	*x = DeclineMasterTransferRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineMasterTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineMasterTransferRequest) ProtoMessage() {}
+
+func (x *DeclineMasterTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineMasterTransferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineMasterTransferRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeclineMasterTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+type DeclineMasterTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *MasterTransferData    `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineMasterTransferResponse) Reset() {
+	*x = DeclineMasterTransferResponse{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineMasterTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineMasterTransferResponse) ProtoMessage() {}
+
+func (x *DeclineMasterTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineMasterTransferResponse.ProtoReflect.Descriptor instead.
+func (*DeclineMasterTransferResponse) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeclineMasterTransferResponse) GetTransfer() *MasterTransferData {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type WatchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoriesIds []string               `protobuf:"bytes,1,rep,name=categories_ids,json=categoriesIds,proto3" json:"categories_ids,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	MasterId      string                 `protobuf:"bytes,3,opt,name=master_id,json=masterId,proto3" json:"master_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WatchOrdersRequest) GetCategoriesIds() []string {
+	if x != nil {
+		return x.CategoriesIds
+	}
+	return nil
+}
+
+func (x *WatchOrdersRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *WatchOrdersRequest) GetMasterId() string {
+	if x != nil {
+		return x.MasterId
+	}
+	return ""
+}
+
+// Изменение заказа
+type OrderChangeEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// order_created, order_updated, order_assigned, order_status_changed, order_deleted,
+	// order_restored, order_unassigned, master_transfer_requested, master_transfer_declined
+	Type           string        `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Order          *v1.OrderData `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	PreviousStatus string        `protobuf:"bytes,4,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	OccurredAt     string        `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Исполнитель до снятия или переназначения
+	PreviousMasterId string `protobuf:"bytes,6,opt,name=previous_master_id,json=previousMasterId,proto3" json:"previous_master_id,omitempty"`
+	// Исполнитель, которому предложена передача заказа
+	TransferMasterId string `protobuf:"bytes,7,opt,name=transfer_master_id,json=transferMasterId,proto3" json:"transfer_master_id,omitempty"`
+	ActorId          string `protobuf:"bytes,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason           string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrderChangeEvent) Reset() {
+	*x = OrderChangeEvent{}
+	mi := &file_orderext_v1_orderext_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderChangeEvent) ProtoMessage() {}
+
+func (x *OrderChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_orderext_v1_orderext_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderChangeEvent.ProtoReflect.Descriptor instead.
+func (*OrderChangeEvent) Descriptor() ([]byte, []int) {
+	return file_orderext_v1_orderext_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OrderChangeEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetOrder() *v1.OrderData {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *OrderChangeEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetPreviousMasterId() string {
+	if x != nil {
+		return x.PreviousMasterId
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetTransferMasterId() string {
+	if x != nil {
+		return x.TransferMasterId
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *OrderChangeEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_orderext_v1_orderext_proto protoreflect.FileDescriptor
+
+const file_orderext_v1_orderext_proto_rawDesc = "" +
+	"\n" +
+	"\x1aorderext/v1/orderext.proto\x12\vorderext.v1\x1a\x16common/v1/common.proto\"\xd1\x01\n" +
+	"\x15OrderStatusChangeData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\"4\n" +
+	"\x17GetOrderTimelineRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"X\n" +
+	"\x18GetOrderTimelineResponse\x12<\n" +
+	"\achanges\x18\x01 \x03(\v2\".orderext.v1.OrderStatusChangeDataR\achanges\"\xb4\x01\n" +
+	"\x16GetNearbyOrdersRequest\x12\x1c\n" +
+	"\tlongitude\x18\x01 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12#\n" +
+	"\rradius_meters\x18\x03 \x01(\x01R\fradiusMeters\x12%\n" +
+	"\x0ecategories_ids\x18\x04 \x03(\tR\rcategoriesIds\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"f\n" +
+	"\x0fNearbyOrderData\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x01R\x0edistanceMeters\"O\n" +
+	"\x17GetNearbyOrdersResponse\x124\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1c.orderext.v1.NearbyOrderDataR\x06orders\"\x98\x01\n" +
+	"\x13SearchOrdersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12%\n" +
+	"\x0ecategories_ids\x18\x02 \x03(\tR\rcategoriesIds\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"D\n" +
+	"\x14SearchOrdersResponse\x12,\n" +
+	"\x06orders\x18\x01 \x03(\v2\x14.common.v1.OrderDataR\x06orders\"\xfa\x01\n" +
+	"\tOfferData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tmaster_id\x18\x03 \x01(\tR\bmasterId\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12!\n" +
+	"\festimated_at\x18\x06 \x01(\tR\vestimatedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\t \x01(\tR\tupdatedAt\"\x9f\x01\n" +
+	"\x12CreateOfferRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x1b\n" +
+	"\tmaster_id\x18\x02 \x01(\tR\bmasterId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12!\n" +
+	"\festimated_at\x18\x05 \x01(\tR\vestimatedAt\"C\n" +
+	"\x13CreateOfferResponse\x12,\n" +
+	"\x05offer\x18\x01 \x01(\v2\x16.orderext.v1.OfferDataR\x05offer\"2\n" +
+	"\x15GetOrderOffersRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"H\n" +
+	"\x16GetOrderOffersResponse\x12.\n" +
+	"\x06offers\x18\x01 \x03(\v2\x16.orderext.v1.OfferDataR\x06offers\"/\n" +
+	"\x12AcceptOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\"A\n" +
+	"\x13AcceptOfferResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"/\n" +
+	"\x12RejectOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\"C\n" +
+	"\x13RejectOfferResponse\x12,\n" +
+	"\x05offer\x18\x01 \x01(\v2\x16.orderext.v1.OfferDataR\x05offer\"%\n" +
+	"\x13RestoreOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x14RestoreOrderResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"?\n" +
+	"\x15UnassignMasterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"D\n" +
+	"\x16UnassignMasterResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"a\n" +
+	"\x1aReassignOrderMasterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tmaster_id\x18\x02 \x01(\tR\bmasterId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"I\n" +
+	"\x1bReassignOrderMasterResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"\xb5\x02\n" +
+	"\x12MasterTransferData\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12$\n" +
+	"\x0efrom_master_id\x18\x03 \x01(\tR\ffromMasterId\x12 \n" +
+	"\fto_master_id\x18\x04 \x01(\tR\n" +
+	"toMasterId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\a \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\b \x01(\tR\tdecidedBy\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\n" +
+	" \x01(\tR\tupdatedAt\"s\n" +
+	"\x1cRequestMasterTransferRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12 \n" +
+	"\fto_master_id\x18\x02 \x01(\tR\n" +
+	"toMasterId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\\\n" +
+	"\x1dRequestMasterTransferResponse\x12;\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1f.orderext.v1.MasterTransferDataR\btransfer\">\n" +
+	"\x1bAcceptMasterTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\"J\n" +
+	"\x1cAcceptMasterTransferResponse\x12*\n" +
+	"\x05order\x18\x01 \x01(\v2\x14.common.v1.OrderDataR\x05order\"?\n" +
+	"\x1cDeclineMasterTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\tR\n" +
+	"transferId\"\\\n" +
+	"\x1dDeclineMasterTransferResponse\x12;\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1f.orderext.v1.MasterTransferDataR\btransfer\"u\n" +
+	"\x12WatchOrdersRequest\x12%\n" +
+	"\x0ecategories_ids\x18\x01 \x03(\tR\rcategoriesIds\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tmaster_id\x18\x03 \x01(\tR\bmasterId\"\xc6\x02\n" +
+	"\x10OrderChangeEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12*\n" +
+	"\x05order\x18\x03 \x01(\v2\x14.common.v1.OrderDataR\x05order\x12'\n" +
+	"\x0fprevious_status\x18\x04 \x01(\tR\x0epreviousStatus\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\x12,\n" +
+	"\x12previous_master_id\x18\x06 \x01(\tR\x10previousMasterId\x12,\n" +
+	"\x12transfer_master_id\x18\a \x01(\tR\x10transferMasterId\x12\x19\n" +
+	"\bactor_id\x18\b \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason2\xae\n" +
+	"\n" +
+	"\x0fOrderExtService\x12_\n" +
+	"\x10GetOrderTimeline\x12$.orderext.v1.GetOrderTimelineRequest\x1a%.orderext.v1.GetOrderTimelineResponse\x12\\\n" +
+	"\x0fGetNearbyOrders\x12#.orderext.v1.GetNearbyOrdersRequest\x1a$.orderext.v1.GetNearbyOrdersResponse\x12S\n" +
+	"\fSearchOrders\x12 .orderext.v1.SearchOrdersRequest\x1a!.orderext.v1.SearchOrdersResponse\x12P\n" +
+	"\vCreateOffer\x12\x1f.orderext.v1.CreateOfferRequest\x1a .orderext.v1.CreateOfferResponse\x12Y\n" +
+	"\x0eGetOrderOffers\x12\".orderext.v1.GetOrderOffersRequest\x1a#.orderext.v1.GetOrderOffersResponse\x12P\n" +
+	"\vAcceptOffer\x12\x1f.orderext.v1.AcceptOfferRequest\x1a .orderext.v1.AcceptOfferResponse\x12P\n" +
+	"\vRejectOffer\x12\x1f.orderext.v1.RejectOfferRequest\x1a .orderext.v1.RejectOfferResponse\x12S\n" +
+	"\fRestoreOrder\x12 .orderext.v1.RestoreOrderRequest\x1a!.orderext.v1.RestoreOrderResponse\x12Y\n" +
+	"\x0eUnassignMaster\x12\".orderext.v1.UnassignMasterRequest\x1a#.orderext.v1.UnassignMasterResponse\x12h\n" +
+	"\x13ReassignOrderMaster\x12'.orderext.v1.ReassignOrderMasterRequest\x1a(.orderext.v1.ReassignOrderMasterResponse\x12n\n" +
+	"\x15RequestMasterTransfer\x12).orderext.v1.RequestMasterTransferRequest\x1a*.orderext.v1.RequestMasterTransferResponse\x12k\n" +
+	"\x14AcceptMasterTransfer\x12(.orderext.v1.AcceptMasterTransferRequest\x1a).orderext.v1.AcceptMasterTransferResponse\x12n\n" +
+	"\x15DeclineMasterTransfer\x12).orderext.v1.DeclineMasterTransferRequest\x1a*.orderext.v1.DeclineMasterTransferResponse\x12O\n" +
+	"\vWatchOrders\x12\x1f.orderext.v1.WatchOrdersRequest\x1a\x1d.orderext.v1.OrderChangeEvent0\x01BWZUgithub.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1;orderextv1b\x06proto3"
+
+var (
+	file_orderext_v1_orderext_proto_rawDescOnce sync.Once
+	file_orderext_v1_orderext_proto_rawDescData []byte
+)
+
+func file_orderext_v1_orderext_proto_rawDescGZIP() []byte {
+	file_orderext_v1_orderext_proto_rawDescOnce.Do(func() {
+		file_orderext_v1_orderext_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_orderext_v1_orderext_proto_rawDesc), len(file_orderext_v1_orderext_proto_rawDesc)))
+	})
+	return file_orderext_v1_orderext_proto_rawDescData
+}
+
+var file_orderext_v1_orderext_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_orderext_v1_orderext_proto_goTypes = []any{
+	(*OrderStatusChangeData)(nil),         // 0: orderext.v1.OrderStatusChangeData
+	(*GetOrderTimelineRequest)(nil),       // 1: orderext.v1.GetOrderTimelineRequest
+	(*GetOrderTimelineResponse)(nil),      // 2: orderext.v1.GetOrderTimelineResponse
+	(*GetNearbyOrdersRequest)(nil),        // 3: orderext.v1.GetNearbyOrdersRequest
+	(*NearbyOrderData)(nil),               // 4: orderext.v1.NearbyOrderData
+	(*GetNearbyOrdersResponse)(nil),       // 5: orderext.v1.GetNearbyOrdersResponse
+	(*SearchOrdersRequest)(nil),           // 6: orderext.v1.SearchOrdersRequest
+	(*SearchOrdersResponse)(nil),          // 7: orderext.v1.SearchOrdersResponse
+	(*OfferData)(nil),                     // 8: orderext.v1.OfferData
+	(*CreateOfferRequest)(nil),            // 9: orderext.v1.CreateOfferRequest
+	(*CreateOfferResponse)(nil),           // 10: orderext.v1.CreateOfferResponse
+	(*GetOrderOffersRequest)(nil),         // 11: orderext.v1.GetOrderOffersRequest
+	(*GetOrderOffersResponse)(nil),        // 12: orderext.v1.GetOrderOffersResponse
+	(*AcceptOfferRequest)(nil),            // 13: orderext.v1.AcceptOfferRequest
+	(*AcceptOfferResponse)(nil),           // 14: orderext.v1.AcceptOfferResponse
+	(*RejectOfferRequest)(nil),            // 15: orderext.v1.RejectOfferRequest
+	(*RejectOfferResponse)(nil),           // 16: orderext.v1.RejectOfferResponse
+	(*RestoreOrderRequest)(nil),           // 17: orderext.v1.RestoreOrderRequest
+	(*RestoreOrderResponse)(nil),          // 18: orderext.v1.RestoreOrderResponse
+	(*UnassignMasterRequest)(nil),         // 19: orderext.v1.UnassignMasterRequest
+	(*UnassignMasterResponse)(nil),        // 20: orderext.v1.UnassignMasterResponse
+	(*ReassignOrderMasterRequest)(nil),    // 21: orderext.v1.ReassignOrderMasterRequest
+	(*ReassignOrderMasterResponse)(nil),   // 22: orderext.v1.ReassignOrderMasterResponse
+	(*MasterTransferData)(nil),            // 23: orderext.v1.MasterTransferData
+	(*RequestMasterTransferRequest)(nil),  // 24: orderext.v1.RequestMasterTransferRequest
+	(*RequestMasterTransferResponse)(nil), // 25: orderext.v1.RequestMasterTransferResponse
+	(*AcceptMasterTransferRequest)(nil),   // 26: orderext.v1.AcceptMasterTransferRequest
+	(*AcceptMasterTransferResponse)(nil),  // 27: orderext.v1.AcceptMasterTransferResponse
+	(*DeclineMasterTransferRequest)(nil),  // 28: orderext.v1.DeclineMasterTransferRequest
+	(*DeclineMasterTransferResponse)(nil), // 29: orderext.v1.DeclineMasterTransferResponse
+	(*WatchOrdersRequest)(nil),            // 30: orderext.v1.WatchOrdersRequest
+	(*OrderChangeEvent)(nil),              // 31: orderext.v1.OrderChangeEvent
+	(*v1.OrderData)(nil),                  // 32: common.v1.OrderData
+}
+var file_orderext_v1_orderext_proto_depIdxs = []int32{
+	0,  // 0: orderext.v1.GetOrderTimelineResponse.changes:type_name -> orderext.v1.OrderStatusChangeData
+	32, // 1: orderext.v1.NearbyOrderData.order:type_name -> common.v1.OrderData
+	4,  // 2: orderext.v1.GetNearbyOrdersResponse.orders:type_name -> orderext.v1.NearbyOrderData
+	32, // 3: orderext.v1.SearchOrdersResponse.orders:type_name -> common.v1.OrderData
+	8,  // 4: orderext.v1.CreateOfferResponse.offer:type_name -> orderext.v1.OfferData
+	8,  // 5: orderext.v1.GetOrderOffersResponse.offers:type_name -> orderext.v1.OfferData
+	32, // 6: orderext.v1.AcceptOfferResponse.order:type_name -> common.v1.OrderData
+	8,  // 7: orderext.v1.RejectOfferResponse.offer:type_name -> orderext.v1.OfferData
+	32, // 8: orderext.v1.RestoreOrderResponse.order:type_name -> common.v1.OrderData
+	32, // 9: orderext.v1.UnassignMasterResponse.order:type_name -> common.v1.OrderData
+	32, // 10: orderext.v1.ReassignOrderMasterResponse.order:type_name -> common.v1.OrderData
+	23, // 11: orderext.v1.RequestMasterTransferResponse.transfer:type_name -> orderext.v1.MasterTransferData
+	32, // 12: orderext.v1.AcceptMasterTransferResponse.order:type_name -> common.v1.OrderData
+	23, // 13: orderext.v1.DeclineMasterTransferResponse.transfer:type_name -> orderext.v1.MasterTransferData
+	32, // 14: orderext.v1.OrderChangeEvent.order:type_name -> common.v1.OrderData
+	1,  // 15: orderext.v1.OrderExtService.GetOrderTimeline:input_type -> orderext.v1.GetOrderTimelineRequest
+	3,  // 16: orderext.v1.OrderExtService.GetNearbyOrders:input_type -> orderext.v1.GetNearbyOrdersRequest
+	6,  // 17: orderext.v1.OrderExtService.SearchOrders:input_type -> orderext.v1.SearchOrdersRequest
+	9,  // 18: orderext.v1.OrderExtService.CreateOffer:input_type -> orderext.v1.CreateOfferRequest
+	11, // 19: orderext.v1.OrderExtService.GetOrderOffers:input_type -> orderext.v1.GetOrderOffersRequest
+	13, // 20: orderext.v1.OrderExtService.AcceptOffer:input_type -> orderext.v1.AcceptOfferRequest
+	15, // 21: orderext.v1.OrderExtService.RejectOffer:input_type -> orderext.v1.RejectOfferRequest
+	17, // 22: orderext.v1.OrderExtService.RestoreOrder:input_type -> orderext.v1.RestoreOrderRequest
+	19, // 23: orderext.v1.OrderExtService.UnassignMaster:input_type -> orderext.v1.UnassignMasterRequest
+	21, // 24: orderext.v1.OrderExtService.ReassignOrderMaster:input_type -> orderext.v1.ReassignOrderMasterRequest
+	24, // 25: orderext.v1.OrderExtService.RequestMasterTransfer:input_type -> orderext.v1.RequestMasterTransferRequest
+	26, // 26: orderext.v1.OrderExtService.AcceptMasterTransfer:input_type -> orderext.v1.AcceptMasterTransferRequest
+	28, // 27: orderext.v1.OrderExtService.DeclineMasterTransfer:input_type -> orderext.v1.DeclineMasterTransferRequest
+	30, // 28: orderext.v1.OrderExtService.WatchOrders:input_type -> orderext.v1.WatchOrdersRequest
+	2,  // 29: orderext.v1.OrderExtService.GetOrderTimeline:output_type -> orderext.v1.GetOrderTimelineResponse
+	5,  // 30: orderext.v1.OrderExtService.GetNearbyOrders:output_type -> orderext.v1.GetNearbyOrdersResponse
+	7,  // 31: orderext.v1.OrderExtService.SearchOrders:output_type -> orderext.v1.SearchOrdersResponse
+	10, // 32: orderext.v1.OrderExtService.CreateOffer:output_type -> orderext.v1.CreateOfferResponse
+	12, // 33: orderext.v1.OrderExtService.GetOrderOffers:output_type -> orderext.v1.GetOrderOffersResponse
+	14, // 34: orderext.v1.OrderExtService.AcceptOffer:output_type -> orderext.v1.AcceptOfferResponse
+	16, // 35: orderext.v1.OrderExtService.RejectOffer:output_type -> orderext.v1.RejectOfferResponse
+	18, // 36: orderext.v1.OrderExtService.RestoreOrder:output_type -> orderext.v1.RestoreOrderResponse
+	20, // 37: orderext.v1.OrderExtService.UnassignMaster:output_type -> orderext.v1.UnassignMasterResponse
+	22, // 38: orderext.v1.OrderExtService.ReassignOrderMaster:output_type -> orderext.v1.ReassignOrderMasterResponse
+	25, // 39: orderext.v1.OrderExtService.RequestMasterTransfer:output_type -> orderext.v1.RequestMasterTransferResponse
+	27, // 40: orderext.v1.OrderExtService.AcceptMasterTransfer:output_type -> orderext.v1.AcceptMasterTransferResponse
+	29, // 41: orderext.v1.OrderExtService.DeclineMasterTransfer:output_type -> orderext.v1.DeclineMasterTransferResponse
+	31, // 42: orderext.v1.OrderExtService.WatchOrders:output_type -> orderext.v1.OrderChangeEvent
+	29, // [29:43] is the sub-list for method output_type
+	15, // [15:29] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_orderext_v1_orderext_proto_init() }
+func file_orderext_v1_orderext_proto_init() {
+	if File_orderext_v1_orderext_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orderext_v1_orderext_proto_rawDesc), len(file_orderext_v1_orderext_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_orderext_v1_orderext_proto_goTypes,
+		DependencyIndexes: file_orderext_v1_orderext_proto_depIdxs,
+		MessageInfos:      file_orderext_v1_orderext_proto_msgTypes,
+	}.Build()
+	File_orderext_v1_orderext_proto = out.File
+	file_orderext_v1_orderext_proto_goTypes = nil
+	file_orderext_v1_orderext_proto_depIdxs = nil
+}
@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: orderext/v1/orderext.proto
+
+package orderextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OrderExtService_GetOrderTimeline_FullMethodName      = "/orderext.v1.OrderExtService/GetOrderTimeline"
+	OrderExtService_GetNearbyOrders_FullMethodName       = "/orderext.v1.OrderExtService/GetNearbyOrders"
+	OrderExtService_SearchOrders_FullMethodName          = "/orderext.v1.OrderExtService/SearchOrders"
+	OrderExtService_CreateOffer_FullMethodName           = "/orderext.v1.OrderExtService/CreateOffer"
+	OrderExtService_GetOrderOffers_FullMethodName        = "/orderext.v1.OrderExtService/GetOrderOffers"
+	OrderExtService_AcceptOffer_FullMethodName           = "/orderext.v1.OrderExtService/AcceptOffer"
+	OrderExtService_RejectOffer_FullMethodName           = "/orderext.v1.OrderExtService/RejectOffer"
+	OrderExtService_RestoreOrder_FullMethodName          = "/orderext.v1.OrderExtService/RestoreOrder"
+	OrderExtService_UnassignMaster_FullMethodName        = "/orderext.v1.OrderExtService/UnassignMaster"
+	OrderExtService_ReassignOrderMaster_FullMethodName   = "/orderext.v1.OrderExtService/ReassignOrderMaster"
+	OrderExtService_RequestMasterTransfer_FullMethodName = "/orderext.v1.OrderExtService/RequestMasterTransfer"
+	OrderExtService_AcceptMasterTransfer_FullMethodName  = "/orderext.v1.OrderExtService/AcceptMasterTransfer"
+	OrderExtService_DeclineMasterTransfer_FullMethodName = "/orderext.v1.OrderExtService/DeclineMasterTransfer"
+	OrderExtService_WatchOrders_FullMethodName           = "/orderext.v1.OrderExtService/WatchOrders"
+)
+
+// OrderExtServiceClient is the client API for OrderExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Расширения OrderService, которые пока не вынесены в course-work-backend-api-specs.
+type OrderExtServiceClient interface {
+	// История изменения статусов заказа
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error)
+	// Активные заказы в радиусе от точки, отсортированные по расстоянию
+	GetNearbyOrders(ctx context.Context, in *GetNearbyOrdersRequest, opts ...grpc.CallOption) (*GetNearbyOrdersResponse, error)
+	// Полнотекстовый поиск по названию, описанию и адресу заказа
+	SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error)
+	// Предложение исполнителя по активному заказу
+	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error)
+	// Все предложения по заказу
+	GetOrderOffers(ctx context.Context, in *GetOrderOffersRequest, opts ...grpc.CallOption) (*GetOrderOffersResponse, error)
+	// Принять предложение: назначить исполнителя, перенести цену и перевести заказ в in_progress
+	AcceptOffer(ctx context.Context, in *AcceptOfferRequest, opts ...grpc.CallOption) (*AcceptOfferResponse, error)
+	// Отклонить предложение
+	RejectOffer(ctx context.Context, in *RejectOfferRequest, opts ...grpc.CallOption) (*RejectOfferResponse, error)
+	// Восстановить мягко удалённый заказ
+	RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...grpc.CallOption) (*RestoreOrderResponse, error)
+	// Снять исполнителя с заказа в работе и вернуть заказ в active
+	UnassignMaster(ctx context.Context, in *UnassignMasterRequest, opts ...grpc.CallOption) (*UnassignMasterResponse, error)
+	// Назначить на заказ другого исполнителя
+	ReassignOrderMaster(ctx context.Context, in *ReassignOrderMasterRequest, opts ...grpc.CallOption) (*ReassignOrderMasterResponse, error)
+	// Предложить передать заказ в работе другому исполнителю
+	RequestMasterTransfer(ctx context.Context, in *RequestMasterTransferRequest, opts ...grpc.CallOption) (*RequestMasterTransferResponse, error)
+	// Принять передачу заказа: исполнитель заказа меняется на принявшего
+	AcceptMasterTransfer(ctx context.Context, in *AcceptMasterTransferRequest, opts ...grpc.CallOption) (*AcceptMasterTransferResponse, error)
+	// Отказаться от передачи заказа
+	DeclineMasterTransfer(ctx context.Context, in *DeclineMasterTransferRequest, opts ...grpc.CallOption) (*DeclineMasterTransferResponse, error)
+	// Поток изменений заказов: лента активных заказов для исполнителя,
+	// изменения собственных заказов для клиента (если указан client_id)
+	// или заказов исполнителя, включая передачи (если указан master_id)
+	WatchOrders(ctx context.Context, in *WatchOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderChangeEvent], error)
+}
+
+type orderExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrderExtServiceClient(cc grpc.ClientConnInterface) OrderExtServiceClient {
+	return &orderExtServiceClient{cc}
+}
+
+func (c *orderExtServiceClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_GetOrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) GetNearbyOrders(ctx context.Context, in *GetNearbyOrdersRequest, opts ...grpc.CallOption) (*GetNearbyOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearbyOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_GetNearbyOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) SearchOrders(ctx context.Context, in *SearchOrdersRequest, opts ...grpc.CallOption) (*SearchOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchOrdersResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_SearchOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOfferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_CreateOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) GetOrderOffers(ctx context.Context, in *GetOrderOffersRequest, opts ...grpc.CallOption) (*GetOrderOffersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderOffersResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_GetOrderOffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) AcceptOffer(ctx context.Context, in *AcceptOfferRequest, opts ...grpc.CallOption) (*AcceptOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOfferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_AcceptOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) RejectOffer(ctx context.Context, in *RejectOfferRequest, opts ...grpc.CallOption) (*RejectOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectOfferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_RejectOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...grpc.CallOption) (*RestoreOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreOrderResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_RestoreOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) UnassignMaster(ctx context.Context, in *UnassignMasterRequest, opts ...grpc.CallOption) (*UnassignMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignMasterResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_UnassignMaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) ReassignOrderMaster(ctx context.Context, in *ReassignOrderMasterRequest, opts ...grpc.CallOption) (*ReassignOrderMasterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignOrderMasterResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_ReassignOrderMaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) RequestMasterTransfer(ctx context.Context, in *RequestMasterTransferRequest, opts ...grpc.CallOption) (*RequestMasterTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestMasterTransferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_RequestMasterTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) AcceptMasterTransfer(ctx context.Context, in *AcceptMasterTransferRequest, opts ...grpc.CallOption) (*AcceptMasterTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptMasterTransferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_AcceptMasterTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) DeclineMasterTransfer(ctx context.Context, in *DeclineMasterTransferRequest, opts ...grpc.CallOption) (*DeclineMasterTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineMasterTransferResponse)
+	err := c.cc.Invoke(ctx, OrderExtService_DeclineMasterTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderExtServiceClient) WatchOrders(ctx context.Context, in *WatchOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderExtService_ServiceDesc.Streams[0], OrderExtService_WatchOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrdersRequest, OrderChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderExtService_WatchOrdersClient = grpc.ServerStreamingClient[OrderChangeEvent]
+
+// OrderExtServiceServer is the server API for OrderExtService service.
+// All implementations must embed UnimplementedOrderExtServiceServer
+// for forward compatibility.
+//
+// Расширения OrderService, которые пока не вынесены в course-work-backend-api-specs.
+type OrderExtServiceServer interface {
+	// История изменения статусов заказа
+	GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error)
+	// Активные заказы в радиусе от точки, отсортированные по расстоянию
+	GetNearbyOrders(context.Context, *GetNearbyOrdersRequest) (*GetNearbyOrdersResponse, error)
+	// Полнотекстовый поиск по названию, описанию и адресу заказа
+	SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error)
+	// Предложение исполнителя по активному заказу
+	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error)
+	// Все предложения по заказу
+	GetOrderOffers(context.Context, *GetOrderOffersRequest) (*GetOrderOffersResponse, error)
+	// Принять предложение: назначить исполнителя, перенести цену и перевести заказ в in_progress
+	AcceptOffer(context.Context, *AcceptOfferRequest) (*AcceptOfferResponse, error)
+	// Отклонить предложение
+	RejectOffer(context.Context, *RejectOfferRequest) (*RejectOfferResponse, error)
+	// Восстановить мягко удалённый заказ
+	RestoreOrder(context.Context, *RestoreOrderRequest) (*RestoreOrderResponse, error)
+	// Снять исполнителя с заказа в работе и вернуть заказ в active
+	UnassignMaster(context.Context, *UnassignMasterRequest) (*UnassignMasterResponse, error)
+	// Назначить на заказ другого исполнителя
+	ReassignOrderMaster(context.Context, *ReassignOrderMasterRequest) (*ReassignOrderMasterResponse, error)
+	// Предложить передать заказ в работе другому исполнителю
+	RequestMasterTransfer(context.Context, *RequestMasterTransferRequest) (*RequestMasterTransferResponse, error)
+	// Принять передачу заказа: исполнитель заказа меняется на принявшего
+	AcceptMasterTransfer(context.Context, *AcceptMasterTransferRequest) (*AcceptMasterTransferResponse, error)
+	// Отказаться от передачи заказа
+	DeclineMasterTransfer(context.Context, *DeclineMasterTransferRequest) (*DeclineMasterTransferResponse, error)
+	// Поток изменений заказов: лента активных заказов для исполнителя,
+	// изменения собственных заказов для клиента (если указан client_id)
+	// или заказов исполнителя, включая передачи (если указан master_id)
+	WatchOrders(*WatchOrdersRequest, grpc.ServerStreamingServer[OrderChangeEvent]) error
+	mustEmbedUnimplementedOrderExtServiceServer()
+}
+
+// UnimplementedOrderExtServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrderExtServiceServer struct{}
+
+func (UnimplementedOrderExtServiceServer) GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedOrderExtServiceServer) GetNearbyOrders(context.Context, *GetNearbyOrdersRequest) (*GetNearbyOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNearbyOrders not implemented")
+}
+func (UnimplementedOrderExtServiceServer) SearchOrders(context.Context, *SearchOrdersRequest) (*SearchOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchOrders not implemented")
+}
+func (UnimplementedOrderExtServiceServer) CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOffer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) GetOrderOffers(context.Context, *GetOrderOffersRequest) (*GetOrderOffersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrderOffers not implemented")
+}
+func (UnimplementedOrderExtServiceServer) AcceptOffer(context.Context, *AcceptOfferRequest) (*AcceptOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptOffer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) RejectOffer(context.Context, *RejectOfferRequest) (*RejectOfferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectOffer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) RestoreOrder(context.Context, *RestoreOrderRequest) (*RestoreOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreOrder not implemented")
+}
+func (UnimplementedOrderExtServiceServer) UnassignMaster(context.Context, *UnassignMasterRequest) (*UnassignMasterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnassignMaster not implemented")
+}
+func (UnimplementedOrderExtServiceServer) ReassignOrderMaster(context.Context, *ReassignOrderMasterRequest) (*ReassignOrderMasterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignOrderMaster not implemented")
+}
+func (UnimplementedOrderExtServiceServer) RequestMasterTransfer(context.Context, *RequestMasterTransferRequest) (*RequestMasterTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestMasterTransfer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) AcceptMasterTransfer(context.Context, *AcceptMasterTransferRequest) (*AcceptMasterTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptMasterTransfer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) DeclineMasterTransfer(context.Context, *DeclineMasterTransferRequest) (*DeclineMasterTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineMasterTransfer not implemented")
+}
+func (UnimplementedOrderExtServiceServer) WatchOrders(*WatchOrdersRequest, grpc.ServerStreamingServer[OrderChangeEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchOrders not implemented")
+}
+func (UnimplementedOrderExtServiceServer) mustEmbedUnimplementedOrderExtServiceServer() {}
+func (UnimplementedOrderExtServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeOrderExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrderExtServiceServer will
+// result in compilation errors.
+type UnsafeOrderExtServiceServer interface {
+	mustEmbedUnimplementedOrderExtServiceServer()
+}
+
+func RegisterOrderExtServiceServer(s grpc.ServiceRegistrar, srv OrderExtServiceServer) {
+	// If the following call panics, it indicates UnimplementedOrderExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrderExtService_ServiceDesc, srv)
+}
+
+func _OrderExtService_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).GetOrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_GetOrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_GetNearbyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearbyOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).GetNearbyOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_GetNearbyOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).GetNearbyOrders(ctx, req.(*GetNearbyOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_SearchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).SearchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_SearchOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).SearchOrders(ctx, req.(*SearchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_CreateOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_GetOrderOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).GetOrderOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_GetOrderOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).GetOrderOffers(ctx, req.(*GetOrderOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_AcceptOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).AcceptOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_AcceptOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).AcceptOffer(ctx, req.(*AcceptOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_RejectOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).RejectOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_RejectOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).RejectOffer(ctx, req.(*RejectOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_RestoreOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).RestoreOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_RestoreOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).RestoreOrder(ctx, req.(*RestoreOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_UnassignMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).UnassignMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_UnassignMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).UnassignMaster(ctx, req.(*UnassignMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_ReassignOrderMaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignOrderMasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).ReassignOrderMaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_ReassignOrderMaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).ReassignOrderMaster(ctx, req.(*ReassignOrderMasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_RequestMasterTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMasterTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).RequestMasterTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_RequestMasterTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).RequestMasterTransfer(ctx, req.(*RequestMasterTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_AcceptMasterTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptMasterTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).AcceptMasterTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_AcceptMasterTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).AcceptMasterTransfer(ctx, req.(*AcceptMasterTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_DeclineMasterTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineMasterTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderExtServiceServer).DeclineMasterTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderExtService_DeclineMasterTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderExtServiceServer).DeclineMasterTransfer(ctx, req.(*DeclineMasterTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderExtService_WatchOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderExtServiceServer).WatchOrders(m, &grpc.GenericServerStream[WatchOrdersRequest, OrderChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderExtService_WatchOrdersServer = grpc.ServerStreamingServer[OrderChangeEvent]
+
+// OrderExtService_ServiceDesc is the grpc.ServiceDesc for OrderExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrderExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orderext.v1.OrderExtService",
+	HandlerType: (*OrderExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _OrderExtService_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "GetNearbyOrders",
+			Handler:    _OrderExtService_GetNearbyOrders_Handler,
+		},
+		{
+			MethodName: "SearchOrders",
+			Handler:    _OrderExtService_SearchOrders_Handler,
+		},
+		{
+			MethodName: "CreateOffer",
+			Handler:    _OrderExtService_CreateOffer_Handler,
+		},
+		{
+			MethodName: "GetOrderOffers",
+			Handler:    _OrderExtService_GetOrderOffers_Handler,
+		},
+		{
+			MethodName: "AcceptOffer",
+			Handler:    _OrderExtService_AcceptOffer_Handler,
+		},
+		{
+			MethodName: "RejectOffer",
+			Handler:    _OrderExtService_RejectOffer_Handler,
+		},
+		{
+			MethodName: "RestoreOrder",
+			Handler:    _OrderExtService_RestoreOrder_Handler,
+		},
+		{
+			MethodName: "UnassignMaster",
+			Handler:    _OrderExtService_UnassignMaster_Handler,
+		},
+		{
+			MethodName: "ReassignOrderMaster",
+			Handler:    _OrderExtService_ReassignOrderMaster_Handler,
+		},
+		{
+			MethodName: "RequestMasterTransfer",
+			Handler:    _OrderExtService_RequestMasterTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptMasterTransfer",
+			Handler:    _OrderExtService_AcceptMasterTransfer_Handler,
+		},
+		{
+			MethodName: "DeclineMasterTransfer",
+			Handler:    _OrderExtService_DeclineMasterTransfer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrders",
+			Handler:       _OrderExtService_WatchOrders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "orderext/v1/orderext.proto",
+}
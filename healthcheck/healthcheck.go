@@ -0,0 +1,146 @@
+// Package healthcheck периодически проверяет зависимости сервиса и публикует
+// результат через стандартный сервис grpc.health.v1.
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Имена сервисов grpc.health.v1, под которыми публикуется состояние зависимостей.
+const (
+	Postgres    = "dependency/postgres"
+	UserService = "dependency/user-service"
+)
+
+const (
+	DefaultInterval = 10 * time.Second
+	DefaultTimeout  = 2 * time.Second
+)
+
+type check struct {
+	name     string
+	critical bool
+	fn       func(ctx context.Context) error
+}
+
+// Checker выполняет проверки зависимостей каждые Interval, ограничивая каждую
+// Timeout. Состояние каждой зависимости публикуется под её именем; сервисы
+// services (в том числе "" — сервер целиком) считаются работающими, только
+// если пройдены все критичные проверки.
+type Checker struct {
+	Interval time.Duration
+	Timeout  time.Duration
+
+	srv      *health.Server
+	services []string
+	checks   []check
+	failing  map[string]bool
+}
+
+// New создаёт Checker. До первой проверки все сервисы находятся в NOT_SERVING.
+func New(srv *health.Server, services ...string) *Checker {
+	for _, name := range services {
+		srv.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	return &Checker{
+		Interval: DefaultInterval,
+		Timeout:  DefaultTimeout,
+		srv:      srv,
+		services: services,
+		failing:  make(map[string]bool),
+	}
+}
+
+// Add добавляет проверку зависимости name. Некритичная зависимость (например,
+// та, без которой сервис работает в деградированном режиме) не влияет на
+// состояние services.
+func (c *Checker) Add(name string, critical bool, fn func(ctx context.Context) error) {
+	c.srv.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+	c.checks = append(c.checks, check{name: name, critical: critical, fn: fn})
+}
+
+// Run выполняет проверки до отмены ctx.
+func (c *Checker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		c.checkAll(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (c *Checker) checkAll(ctx context.Context) {
+	errs := make([]error, len(c.checks))
+	var wg sync.WaitGroup
+	for i, ch := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, c.Timeout)
+			defer cancel()
+			errs[i] = ch.fn(ctx)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	serving := true
+	for i, ch := range c.checks {
+		err := errs[i]
+		if err != nil && !c.failing[ch.name] {
+			log.Printf("health: %s is unhealthy: %v", ch.name, err)
+		} else if err == nil && c.failing[ch.name] {
+			log.Printf("health: %s recovered", ch.name)
+		}
+		c.failing[ch.name] = err != nil
+		c.srv.SetServingStatus(ch.name, status(err == nil))
+		if err != nil && ch.critical {
+			serving = false
+		}
+	}
+	for _, name := range c.services {
+		c.srv.SetServingStatus(name, status(serving))
+	}
+}
+
+func status(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// ConnCheck возвращает проверку, что соединение conn установлено или может быть
+// установлено за время проверки.
+func ConnCheck(conn *grpc.ClientConn) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		conn.Connect()
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.Shutdown:
+				return fmt.Errorf("connection is shut down")
+			}
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("connection is %s", state)
+			}
+		}
+	}
+}
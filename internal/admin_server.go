@@ -0,0 +1,176 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	orderextpbv1 "github.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1"
+	"github.com/google/uuid"
+)
+
+// MaxReasonLen — максимальная длина причины действия над заказом в символах.
+const MaxReasonLen = 1000
+
+// AdminServer реализует OrderAdminService — операции поддержки над заказами
+// любых пользователей. Кому они доступны, решает политика методов.
+type AdminServer struct {
+	orderextpbv1.UnimplementedOrderAdminServiceServer
+	svc   Service
+	users *UserResolver
+}
+
+func NewAdminServer(svc Service, users *UserResolver) *AdminServer {
+	return &AdminServer{svc: svc, users: users}
+}
+
+// parseReason проверяет обязательную причину действия над заказом.
+func parseReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", invalidField("reason", "укажите причину")
+	}
+	if len([]rune(reason)) > MaxReasonLen {
+		return "", invalidField("reason", "причина слишком длинная")
+	}
+	return reason, nil
+}
+
+// checkMaster проверяет в сервисе пользователей, что id — исполнитель.
+// Ошибки проверки относятся к полю field запроса.
+func checkMaster(ctx context.Context, users *UserResolver, field string, id uuid.UUID) error {
+	if _, err := users.GetMaster(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, ErrUserNotFound):
+			return invalidField(field, "исполнитель не найден")
+		case errors.Is(err, ErrUserNotMaster):
+			return invalidField(field, err.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *AdminServer) ForceOrderStatus(ctx context.Context, req *orderextpbv1.ForceOrderStatusRequest) (*orderextpbv1.ForceOrderStatusResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.ForceStatus(ctx, id, req.Status, actorID(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.ForceOrderStatusResponse{Order: s.users.OrderData(ctx, o)}, nil
+}
+
+func (s *AdminServer) ReassignMaster(ctx context.Context, req *orderextpbv1.ReassignMasterRequest) (*orderextpbv1.ReassignMasterResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	master_id, err := uuid.Parse(req.MasterId)
+	if err != nil {
+		return nil, invalidField("master_id", "неправильный формат UUID исполнителя")
+	}
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMaster(ctx, s.users, "master_id", master_id); err != nil {
+		return nil, err
+	}
+	o, err := s.svc.ReassignMaster(ctx, id, master_id, actorID(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.ReassignMasterResponse{Order: s.users.OrderData(ctx, o)}, nil
+}
+
+func (s *AdminServer) HardDeleteOrder(ctx context.Context, req *orderextpbv1.HardDeleteOrderRequest) (*orderextpbv1.HardDeleteOrderResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.HardDelete(ctx, id, actorID(ctx), reason); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.HardDeleteOrderResponse{}, nil
+}
+
+func (s *AdminServer) ListAllOrders(ctx context.Context, req *orderextpbv1.ListAllOrdersRequest) (*orderextpbv1.ListAllOrdersResponse, error) {
+	filter := OrderFilter{
+		Query:          req.Query,
+		IncludeDeleted: req.IncludeDeleted,
+		MinPrice:       req.MinPrice,
+		MaxPrice:       req.MaxPrice,
+	}
+	var err error
+	if req.ClientId != "" {
+		if filter.ClientID, err = uuid.Parse(req.ClientId); err != nil {
+			return nil, invalidField("client_id", "неправильный формат UUID")
+		}
+	}
+	if req.MasterId != "" {
+		if filter.MasterID, err = uuid.Parse(req.MasterId); err != nil {
+			return nil, invalidField("master_id", "неправильный формат UUID")
+		}
+	}
+	for _, id := range req.CategoriesIds {
+		cid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, invalidField("categories_ids", "неправильный формат UUID категории")
+		}
+		filter.CategoryIDs = append(filter.CategoryIDs, cid)
+	}
+	for _, st := range req.Statuses {
+		status, err := parseStatus(st)
+		if err != nil {
+			return nil, invalidField("statuses", err.Error())
+		}
+		filter.Statuses = append(filter.Statuses, status)
+	}
+	if req.CreatedAfter != "" {
+		if filter.CreatedAfter, err = time.Parse(time.RFC3339, req.CreatedAfter); err != nil {
+			return nil, invalidField("created_after", "ожидается дата в формате RFC 3339")
+		}
+	}
+	if req.CreatedBefore != "" {
+		if filter.CreatedBefore, err = time.Parse(time.RFC3339, req.CreatedBefore); err != nil {
+			return nil, invalidField("created_before", "ожидается дата в формате RFC 3339")
+		}
+	}
+
+	sort, err := ParseSortOrder(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	if req.PageSize < 0 {
+		return nil, ErrInvalidPageSize
+	}
+	page := PageRequest{Size: int(req.PageSize), Cursor: req.PageToken, Sort: sort}
+
+	res, err := s.svc.ListAll(ctx, filter, page)
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.ListAllOrdersResponse{
+		Orders:        s.users.OrdersData(ctx, res.Orders),
+		NextPageToken: res.NextCursor,
+		TotalCount:    int32(res.Total),
+	}, nil
+}
@@ -0,0 +1,158 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPermissionDenied     = errors.New("нельзя действовать от имени другого пользователя")
+	ErrNotOrderOwner        = errors.New("изменять заказ может только его клиент")
+	ErrNotAssignedMaster    = errors.New("отметить заказ выполненным может только назначенный исполнитель")
+	ErrTransitionNotAllowed = errors.New("роли пользователя недоступен этот переход статуса")
+	ErrNotOrderParticipant  = errors.New("заказ доступен только его клиенту и исполнителю")
+	ErrFeedNotAllowed       = errors.New("ленту активных заказов видят только исполнители")
+)
+
+// caller возвращает пользователя, выполняющего запрос.
+func caller(ctx context.Context) (auth.Identity, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return id, auth.ErrUnauthenticated
+	}
+	return id, nil
+}
+
+// actorID возвращает ID пользователя, выполняющего запрос, или uuid.Nil.
+func actorID(ctx context.Context) uuid.UUID {
+	id, _ := auth.FromContext(ctx)
+	return id.UserID
+}
+
+// authorizeUser разрешает действие от имени user_id только самому пользователю
+// и администратору.
+func authorizeUser(ctx context.Context, user_id uuid.UUID) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if c.IsAdmin() || c.UserID == user_id {
+		return nil
+	}
+	return ErrPermissionDenied
+}
+
+// authorizeOwner разрешает изменение заказа o только его клиенту и администратору.
+func authorizeOwner(ctx context.Context, o *ent.Order) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if c.IsAdmin() || c.UserID == o.ClientID {
+		return nil
+	}
+	return ErrNotOrderOwner
+}
+
+// authorizeMaster разрешает действие над заказом o только назначенному
+// исполнителю и администратору.
+func authorizeMaster(ctx context.Context, o *ent.Order) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if c.IsAdmin() || (o.MasterID != uuid.Nil && c.UserID == o.MasterID) {
+		return nil
+	}
+	return ErrNotAssignedMaster
+}
+
+// authorizeTransition разрешает перевод заказа из from в to только ролям,
+// указанным в политике доступа.
+func authorizeTransition(ctx context.Context, policy *auth.Policy, from, to order.Status) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if from == to || policy.AllowTransition(c.Role, from.String(), to.String()) {
+		return nil
+	}
+	return ErrTransitionNotAllowed
+}
+
+// authorizeParticipant разрешает действие над заказом o его клиенту,
+// назначенному исполнителю и администратору.
+func authorizeParticipant(ctx context.Context, o *ent.Order) error {
+	if err := authorizeOwner(ctx, o); err == nil {
+		return nil
+	}
+	return authorizeMaster(ctx, o)
+}
+
+// canModerate сообщает, может ли пользователь c просматривать любые заказы.
+func canModerate(c auth.Identity) bool {
+	return c.IsAdmin() || c.Role == auth.RoleModerator
+}
+
+// authorizeRead разрешает просмотр заказа o его участникам, модератору и
+// администратору. Активные заказы видят все исполнители: из них они выбирают,
+// на что откликнуться.
+func authorizeRead(ctx context.Context, o *ent.Order) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if canModerate(c) || (c.Role == auth.RoleMaster && o.Status == order.StatusActive) {
+		return nil
+	}
+	if c.UserID == o.ClientID || (o.MasterID != uuid.Nil && c.UserID == o.MasterID) {
+		return nil
+	}
+	return ErrNotOrderParticipant
+}
+
+// readableOrders возвращает условие на заказы, которые пользователь c может
+// просматривать по тем же правилам, что и authorizeRead; nil — любые заказы.
+func readableOrders(c auth.Identity) predicate.Order {
+	if canModerate(c) {
+		return nil
+	}
+	visible := []predicate.Order{order.ClientIDEQ(c.UserID), order.MasterIDEQ(c.UserID)}
+	if c.Role == auth.RoleMaster {
+		visible = append(visible, order.StatusEQ(order.StatusActive))
+	}
+	return order.Or(visible...)
+}
+
+// authorizeList разрешает список заказов с фильтром по клиенту client_id и
+// исполнителю master_id, только если один из них — сам пользователь.
+// Модератору и администратору доступны любые списки.
+func authorizeList(ctx context.Context, client_id, master_id uuid.UUID) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if canModerate(c) || c.UserID == client_id || c.UserID == master_id {
+		return nil
+	}
+	return ErrPermissionDenied
+}
+
+// authorizeFeed разрешает подписку на ленту всех активных заказов исполнителям,
+// модератору и администратору: в ленте есть адреса и клиенты чужих заказов.
+func authorizeFeed(ctx context.Context) error {
+	c, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	if c.Role == auth.RoleMaster || canModerate(c) {
+		return nil
+	}
+	return ErrFeedNotAllowed
+}
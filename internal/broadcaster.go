@@ -0,0 +1,169 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+)
+
+// DefaultSubscriberBuffer — сколько изменений может накопиться у подписчика,
+// прежде чем он будет отключён как не успевающий.
+const DefaultSubscriberBuffer = 64
+
+// OrderChange — изменение заказа, рассылаемое подписчикам WatchOrders.
+type OrderChange struct {
+	EventID uuid.UUID
+	Type    EventType
+	Event   OrderEvent
+}
+
+// Subscription — подписка на изменения заказов.
+// C закрывается при Close или если подписчик не успевает забирать изменения;
+// во втором случае Lagged возвращает true.
+type Subscription struct {
+	C <-chan OrderChange
+
+	b      *Broadcaster
+	ch     chan OrderChange
+	filter func(*OrderChange) bool
+	lagged bool
+}
+
+// Close отписывает подписчика. Повторный вызов безопасен.
+func (s *Subscription) Close() {
+	s.b.remove(s, false)
+}
+
+// Lagged сообщает, была ли подписка закрыта из-за переполнения буфера.
+func (s *Subscription) Lagged() bool {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	return s.lagged
+}
+
+// Broadcaster рассылает изменения заказов подписчикам внутри процесса.
+// Отправка никогда не блокируется: подписчик с переполненным буфером отключается.
+// Реализует Publisher, поэтому его можно подключить к Relay или Listener.
+type Broadcaster struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	buffer int
+	closed bool
+}
+
+func NewBroadcaster(buffer int) *Broadcaster {
+	if buffer <= 0 {
+		buffer = DefaultSubscriberBuffer
+	}
+	return &Broadcaster{subs: make(map[*Subscription]struct{}), buffer: buffer}
+}
+
+// Subscribe подписывает на изменения, для которых filter возвращает true.
+func (b *Broadcaster) Subscribe(filter func(*OrderChange) bool) *Subscription {
+	ch := make(chan OrderChange, b.buffer)
+	sub := &Subscription{C: ch, b: b, ch: ch, filter: filter}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(ch)
+		return sub
+	}
+	b.subs[sub] = struct{}{}
+	return sub
+}
+
+// Close закрывает все подписки; новые подписки сразу закрыты.
+// Вызывается при остановке сервиса, чтобы потоки WatchOrders завершились.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		b.removeLocked(sub, false)
+	}
+}
+
+func (b *Broadcaster) remove(sub *Subscription, lagged bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.removeLocked(sub, lagged)
+}
+
+func (b *Broadcaster) removeLocked(sub *Subscription, lagged bool) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	sub.lagged = lagged
+	close(sub.ch)
+}
+
+// Broadcast отправляет изменение всем подходящим подписчикам.
+func (b *Broadcaster) Broadcast(change OrderChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs {
+		if sub.filter != nil && !sub.filter(&change) {
+			continue
+		}
+		select {
+		case sub.ch <- change:
+		default:
+			b.removeLocked(sub, true)
+		}
+	}
+}
+
+// Publish разбирает событие outbox и рассылает его подписчикам.
+func (b *Broadcaster) Publish(ctx context.Context, event *ent.OutboxEvent) error {
+	var ev OrderEvent
+	if err := json.Unmarshal(event.Payload, &ev); err != nil {
+		return err
+	}
+	b.Broadcast(OrderChange{EventID: event.ID, Type: event.Type, Event: ev})
+	return nil
+}
+
+// ActiveOrdersFilter отбирает изменения ленты исполнителя: как и GetAllActive —
+// активные заказы из указанных категорий (все категории, если список пуст).
+// Изменения заказов, только что вышедших из active, тоже пропускаются, чтобы
+// клиент мог убрать их из ленты.
+func ActiveOrdersFilter(categories_ids []uuid.UUID) func(*OrderChange) bool {
+	return func(c *OrderChange) bool {
+		if c.Event.Status != "active" && c.Event.PreviousStatus != "active" {
+			return false
+		}
+		if len(categories_ids) == 0 {
+			return true
+		}
+		for _, id := range categories_ids {
+			if c.Event.CategoryID == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ClientOrdersFilter отбирает изменения заказов клиента client_id.
+func ClientOrdersFilter(client_id uuid.UUID) func(*OrderChange) bool {
+	return func(c *OrderChange) bool {
+		return c.Event.ClientID == client_id
+	}
+}
+
+// MasterOrdersFilter отбирает изменения, касающиеся исполнителя master_id: его
+// заказы, снятие с заказа и передачи заказов ему.
+func MasterOrdersFilter(master_id uuid.UUID) func(*OrderChange) bool {
+	is := func(id *uuid.UUID) bool {
+		return id != nil && *id == master_id
+	}
+	return func(c *OrderChange) bool {
+		return is(c.Event.MasterID) || is(c.Event.PreviousMasterID) || is(c.Event.TransferMasterID)
+	}
+}
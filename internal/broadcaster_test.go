@@ -0,0 +1,151 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+)
+
+// drain забирает из подписки всё, что в ней уже есть, не блокируясь.
+func drain(sub *Subscription) (changes []OrderChange, open bool) {
+	for {
+		select {
+		case c, ok := <-sub.C:
+			if !ok {
+				return changes, false
+			}
+			changes = append(changes, c)
+		default:
+			return changes, true
+		}
+	}
+}
+
+func TestBroadcasterDeliversToAllSubscribers(t *testing.T) {
+	b := NewBroadcaster(4)
+	subs := []*Subscription{b.Subscribe(nil), b.Subscribe(nil), b.Subscribe(nil)}
+
+	order_id := uuid.New()
+	payload, err := json.Marshal(OrderEvent{OrderID: order_id, Status: "active"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &ent.OutboxEvent{ID: uuid.New(), Type: EventOrderCreated, Payload: payload}
+	if err := b.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() = %v", err)
+	}
+
+	for i, sub := range subs {
+		changes, open := drain(sub)
+		if !open {
+			t.Fatalf("subscriber %d closed, want open", i)
+		}
+		if len(changes) != 1 {
+			t.Fatalf("subscriber %d got %d changes, want 1", i, len(changes))
+		}
+		c := changes[0]
+		if c.EventID != event.ID || c.Type != EventOrderCreated || c.Event.OrderID != order_id {
+			t.Errorf("subscriber %d got %+v, want event %s for order %s", i, c, event.ID, order_id)
+		}
+	}
+
+	subs[0].Close()
+	subs[0].Close()
+	b.Broadcast(OrderChange{EventID: uuid.New()})
+	if _, open := drain(subs[0]); open {
+		t.Error("closed subscriber still open")
+	}
+	if subs[0].Lagged() {
+		t.Error("Lagged() after Close = true, want false")
+	}
+	for i, sub := range subs[1:] {
+		if changes, _ := drain(sub); len(changes) != 1 {
+			t.Errorf("subscriber %d got %d changes after another closed, want 1", i+1, len(changes))
+		}
+	}
+}
+
+func TestBroadcasterFilters(t *testing.T) {
+	client := uuid.New()
+	master := uuid.New()
+	other := uuid.New()
+	category := uuid.New()
+
+	tests := []struct {
+		name   string
+		filter func(*OrderChange) bool
+		event  OrderEvent
+		want   bool
+	}{
+		{"active: active order", ActiveOrdersFilter(nil), OrderEvent{Status: "active"}, true},
+		{"active: left active", ActiveOrdersFilter(nil), OrderEvent{Status: "in_progress", PreviousStatus: "active"}, true},
+		{"active: in progress", ActiveOrdersFilter(nil), OrderEvent{Status: "in_progress"}, false},
+		{"active: matching category", ActiveOrdersFilter([]uuid.UUID{other, category}), OrderEvent{Status: "active", CategoryID: category}, true},
+		{"active: other category", ActiveOrdersFilter([]uuid.UUID{other}), OrderEvent{Status: "active", CategoryID: category}, false},
+
+		{"client: own order", ClientOrdersFilter(client), OrderEvent{ClientID: client}, true},
+		{"client: other order", ClientOrdersFilter(client), OrderEvent{ClientID: other}, false},
+
+		{"master: assigned", MasterOrdersFilter(master), OrderEvent{MasterID: &master}, true},
+		{"master: unassigned", MasterOrdersFilter(master), OrderEvent{PreviousMasterID: &master}, true},
+		{"master: transfer offered", MasterOrdersFilter(master), OrderEvent{MasterID: &other, TransferMasterID: &master}, true},
+		{"master: other master", MasterOrdersFilter(master), OrderEvent{MasterID: &other}, false},
+		{"master: no master", MasterOrdersFilter(master), OrderEvent{Status: "active"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBroadcaster(1)
+			sub := b.Subscribe(tt.filter)
+			b.Broadcast(OrderChange{EventID: uuid.New(), Event: tt.event})
+			changes, _ := drain(sub)
+			if got := len(changes) == 1; got != tt.want {
+				t.Errorf("delivered = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBroadcasterDropsLaggingSubscriber(t *testing.T) {
+	b := NewBroadcaster(2)
+	slow := b.Subscribe(nil)
+	fast := b.Subscribe(nil)
+
+	for range 2 {
+		b.Broadcast(OrderChange{EventID: uuid.New()})
+	}
+	if changes, _ := drain(fast); len(changes) != 2 {
+		t.Fatalf("fast subscriber got %d changes, want 2", len(changes))
+	}
+	b.Broadcast(OrderChange{EventID: uuid.New()})
+
+	changes, open := drain(slow)
+	if open {
+		t.Fatal("lagging subscriber still open")
+	}
+	if len(changes) != 2 {
+		t.Errorf("lagging subscriber got %d buffered changes, want 2", len(changes))
+	}
+	if !slow.Lagged() {
+		t.Error("Lagged() = false, want true")
+	}
+
+	changes, open = drain(fast)
+	if !open || len(changes) != 1 || fast.Lagged() {
+		t.Errorf("fast subscriber: open = %t, changes = %d, lagged = %t; want open with 1 change", open, len(changes), fast.Lagged())
+	}
+}
+
+func TestBroadcasterClose(t *testing.T) {
+	b := NewBroadcaster(1)
+	sub := b.Subscribe(nil)
+	b.Close()
+	if _, open := drain(sub); open {
+		t.Error("subscription open after Broadcaster.Close")
+	}
+	if _, open := drain(b.Subscribe(nil)); open {
+		t.Error("subscription after Broadcaster.Close is open")
+	}
+}
@@ -0,0 +1,183 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain — домен ошибок сервиса в google.rpc.ErrorInfo.
+const ErrorDomain = "order-service"
+
+// FieldError — ошибка в значении поля запроса.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+func invalidField(field, message string) error {
+	return &FieldError{Field: field, Message: message}
+}
+
+// domainError — код gRPC и стабильный код причины для доменной ошибки.
+type domainError struct {
+	err    error
+	code   codes.Code
+	reason string
+}
+
+// domainErrors сопоставляет доменные ошибки кодам gRPC. Причины (reason) — часть
+// контракта API: шлюз ветвится по ним, поэтому их нельзя переименовывать.
+var domainErrors = []domainError{
+	{ErrOrderNotFound, codes.NotFound, "ORDER_NOT_FOUND"},
+	{ErrOfferNotFound, codes.NotFound, "OFFER_NOT_FOUND"},
+	{ErrUserNotFound, codes.NotFound, "USER_NOT_FOUND"},
+	{ErrTransferNotFound, codes.NotFound, "TRANSFER_NOT_FOUND"},
+
+	{ErrOrderAlreadyExists, codes.AlreadyExists, "ORDER_ALREADY_EXISTS"},
+	{ErrOfferAlreadyExists, codes.AlreadyExists, "OFFER_ALREADY_EXISTS"},
+	{ErrIdempotencyKeyReused, codes.AlreadyExists, "IDEMPOTENCY_KEY_REUSED"},
+	{ErrTransferAlreadyPending, codes.AlreadyExists, "TRANSFER_ALREADY_PENDING"},
+
+	{ErrInvalidId, codes.InvalidArgument, "INVALID_ID"},
+	{ErrInvalidStatus, codes.InvalidArgument, "INVALID_STATUS"},
+	{ErrInvalidCursor, codes.InvalidArgument, "INVALID_PAGE_TOKEN"},
+	{ErrInvalidSort, codes.InvalidArgument, "INVALID_SORT"},
+	{ErrInvalidPageSize, codes.InvalidArgument, "INVALID_PAGE_SIZE"},
+	{ErrInvalidVersion, codes.InvalidArgument, "INVALID_VERSION"},
+	{ErrInvalidIdempotencyKey, codes.InvalidArgument, "INVALID_IDEMPOTENCY_KEY"},
+	{ErrInvalidCoordinates, codes.InvalidArgument, "INVALID_COORDINATES"},
+	{ErrInvalidRadius, codes.InvalidArgument, "INVALID_RADIUS"},
+	{ErrEmptySearchQuery, codes.InvalidArgument, "EMPTY_SEARCH_QUERY"},
+	{ErrInvalidOfferPrice, codes.InvalidArgument, "INVALID_OFFER_PRICE"},
+	{ErrInvalidEstimatedAt, codes.InvalidArgument, "INVALID_ESTIMATED_AT"},
+
+	{auth.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED"},
+	{auth.ErrInvalidToken, codes.Unauthenticated, "INVALID_TOKEN"},
+	{auth.ErrMethodNotAllowed, codes.PermissionDenied, "METHOD_NOT_ALLOWED"},
+
+	{ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED"},
+	{ErrNotOrderOwner, codes.PermissionDenied, "NOT_ORDER_OWNER"},
+	{ErrNotAssignedMaster, codes.PermissionDenied, "NOT_ASSIGNED_MASTER"},
+	{ErrTransitionNotAllowed, codes.PermissionDenied, "TRANSITION_NOT_ALLOWED"},
+	{ErrNotOrderParticipant, codes.PermissionDenied, "NOT_ORDER_PARTICIPANT"},
+	{ErrFeedNotAllowed, codes.PermissionDenied, "FEED_NOT_ALLOWED"},
+
+	{ErrOrderNotActive, codes.FailedPrecondition, "ORDER_NOT_ACTIVE"},
+	{ErrOfferNotPending, codes.FailedPrecondition, "OFFER_NOT_PENDING"},
+	{ErrOrderNotDeleted, codes.FailedPrecondition, "ORDER_NOT_DELETED"},
+	{ErrOrderClosed, codes.FailedPrecondition, "ORDER_CLOSED"},
+	{ErrUserNotMaster, codes.FailedPrecondition, "USER_NOT_MASTER"},
+	{ErrOrderNotInProgress, codes.FailedPrecondition, "ORDER_NOT_IN_PROGRESS"},
+	{ErrTransferNotPending, codes.FailedPrecondition, "TRANSFER_NOT_PENDING"},
+	{ErrTransferStale, codes.FailedPrecondition, "TRANSFER_STALE"},
+
+	{ErrVersionConflict, codes.Aborted, "VERSION_CONFLICT"},
+
+	{ErrUserServiceUnavailable, codes.Unavailable, "USER_SERVICE_UNAVAILABLE"},
+
+	{ErrGetOrderFailed, codes.Internal, "GET_ORDER_FAILED"},
+	{ErrGetAllOrderFailed, codes.Internal, "LIST_ORDERS_FAILED"},
+	{ErrCreateOrderFailed, codes.Internal, "CREATE_ORDER_FAILED"},
+	{ErrUpdateOrderFailed, codes.Internal, "UPDATE_ORDER_FAILED"},
+	{ErrRestoreOrderFailed, codes.Internal, "RESTORE_ORDER_FAILED"},
+	{ErrPurgeOrdersFailed, codes.Internal, "PURGE_ORDERS_FAILED"},
+	{ErrHardDeleteFailed, codes.Internal, "HARD_DELETE_FAILED"},
+	{ErrTransferFailed, codes.Internal, "TRANSFER_FAILED"},
+	{ErrGetTimelineFailed, codes.Internal, "GET_TIMELINE_FAILED"},
+	{ErrSearchFailed, codes.Internal, "SEARCH_FAILED"},
+	{ErrCreateOfferFailed, codes.Internal, "CREATE_OFFER_FAILED"},
+	{ErrGetOffersFailed, codes.Internal, "GET_OFFERS_FAILED"},
+	{ErrUpdateOfferFailed, codes.Internal, "UPDATE_OFFER_FAILED"},
+	{ErrGetEventsFailed, codes.Internal, "GET_EVENTS_FAILED"},
+}
+
+// ToStatus переводит ошибку в статус gRPC с деталями google.rpc.ErrorInfo.
+// Ошибки, уже являющиеся статусами, дополняются ErrorInfo с причиной по коду,
+// если деталей у них нет. Неизвестные ошибки становятся Internal.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if st, ok := status.FromError(err); ok {
+		if st.Code() == codes.OK || len(st.Details()) > 0 {
+			return err
+		}
+		return withErrorInfo(st, st.Code().String(), nil)
+	}
+
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return withErrorInfo(status.New(codes.InvalidArgument, validationErr.Error()), "VALIDATION_FAILED",
+			nil, validationErr.badRequest())
+	}
+
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		br := (&ValidationError{Violations: []FieldError{*fieldErr}}).badRequest()
+		return withErrorInfo(status.New(codes.InvalidArgument, fieldErr.Message), "INVALID_FIELD",
+			map[string]string{"field": fieldErr.Field}, br)
+	}
+
+	var transitionErr *ErrInvalidTransition
+	if errors.As(err, &transitionErr) {
+		return withErrorInfo(status.New(codes.FailedPrecondition, err.Error()), "INVALID_STATUS_TRANSITION",
+			map[string]string{"from": transitionErr.From.String(), "to": transitionErr.To.String()})
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	for _, de := range domainErrors {
+		if errors.Is(err, de.err) {
+			return withErrorInfo(status.New(de.code, err.Error()), de.reason, nil)
+		}
+	}
+
+	log.Printf("unexpected error: %v", err)
+	return withErrorInfo(status.New(codes.Internal, "внутренняя ошибка сервиса"), "INTERNAL", nil)
+}
+
+// withErrorInfo добавляет к статусу google.rpc.ErrorInfo и детали extra.
+func withErrorInfo(st *status.Status, reason string, metadata map[string]string, extra ...protoadapt.MessageV1) error {
+	details := append([]protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	}}, extra...)
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// UnaryErrorInterceptor переводит ошибки unary-обработчиков в статусы gRPC.
+func UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+	return resp, nil
+}
+
+// StreamErrorInterceptor переводит ошибки потоковых обработчиков в статусы gRPC.
+func StreamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return ToStatus(handler(srv, ss))
+}
@@ -0,0 +1,231 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusDetails разбирает статус err на ErrorInfo и BadRequest.
+func statusDetails(t *testing.T, err error) (*status.Status, *errdetails.ErrorInfo, *errdetails.BadRequest) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("ToStatus() = %v, want gRPC status", err)
+	}
+	var (
+		info *errdetails.ErrorInfo
+		br   *errdetails.BadRequest
+	)
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			br = d
+		default:
+			t.Errorf("unexpected detail %T", d)
+		}
+	}
+	if info == nil {
+		t.Fatalf("ToStatus(%v) has no ErrorInfo", err)
+	}
+	if info.Domain != ErrorDomain {
+		t.Errorf("ErrorInfo.Domain = %q, want %q", info.Domain, ErrorDomain)
+	}
+	return st, info, br
+}
+
+// Причины — контракт API, поэтому таблица перечисляет их явно, а не
+// повторяет domainErrors.
+func TestToStatusDomainErrors(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{ErrOrderNotFound, codes.NotFound, "ORDER_NOT_FOUND"},
+		{ErrOfferNotFound, codes.NotFound, "OFFER_NOT_FOUND"},
+		{ErrUserNotFound, codes.NotFound, "USER_NOT_FOUND"},
+		{ErrTransferNotFound, codes.NotFound, "TRANSFER_NOT_FOUND"},
+
+		{ErrOrderAlreadyExists, codes.AlreadyExists, "ORDER_ALREADY_EXISTS"},
+		{ErrOfferAlreadyExists, codes.AlreadyExists, "OFFER_ALREADY_EXISTS"},
+		{ErrIdempotencyKeyReused, codes.AlreadyExists, "IDEMPOTENCY_KEY_REUSED"},
+		{ErrTransferAlreadyPending, codes.AlreadyExists, "TRANSFER_ALREADY_PENDING"},
+
+		{ErrInvalidId, codes.InvalidArgument, "INVALID_ID"},
+		{ErrInvalidStatus, codes.InvalidArgument, "INVALID_STATUS"},
+		{ErrInvalidCursor, codes.InvalidArgument, "INVALID_PAGE_TOKEN"},
+		{ErrInvalidSort, codes.InvalidArgument, "INVALID_SORT"},
+		{ErrInvalidPageSize, codes.InvalidArgument, "INVALID_PAGE_SIZE"},
+		{ErrInvalidVersion, codes.InvalidArgument, "INVALID_VERSION"},
+		{ErrInvalidIdempotencyKey, codes.InvalidArgument, "INVALID_IDEMPOTENCY_KEY"},
+		{ErrInvalidCoordinates, codes.InvalidArgument, "INVALID_COORDINATES"},
+		{ErrInvalidRadius, codes.InvalidArgument, "INVALID_RADIUS"},
+		{ErrEmptySearchQuery, codes.InvalidArgument, "EMPTY_SEARCH_QUERY"},
+		{ErrInvalidOfferPrice, codes.InvalidArgument, "INVALID_OFFER_PRICE"},
+		{ErrInvalidEstimatedAt, codes.InvalidArgument, "INVALID_ESTIMATED_AT"},
+
+		{auth.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED"},
+		{auth.ErrInvalidToken, codes.Unauthenticated, "INVALID_TOKEN"},
+		{auth.ErrMethodNotAllowed, codes.PermissionDenied, "METHOD_NOT_ALLOWED"},
+
+		{ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED"},
+		{ErrNotOrderOwner, codes.PermissionDenied, "NOT_ORDER_OWNER"},
+		{ErrNotAssignedMaster, codes.PermissionDenied, "NOT_ASSIGNED_MASTER"},
+		{ErrTransitionNotAllowed, codes.PermissionDenied, "TRANSITION_NOT_ALLOWED"},
+		{ErrNotOrderParticipant, codes.PermissionDenied, "NOT_ORDER_PARTICIPANT"},
+		{ErrFeedNotAllowed, codes.PermissionDenied, "FEED_NOT_ALLOWED"},
+
+		{ErrOrderNotActive, codes.FailedPrecondition, "ORDER_NOT_ACTIVE"},
+		{ErrOfferNotPending, codes.FailedPrecondition, "OFFER_NOT_PENDING"},
+		{ErrOrderNotDeleted, codes.FailedPrecondition, "ORDER_NOT_DELETED"},
+		{ErrOrderClosed, codes.FailedPrecondition, "ORDER_CLOSED"},
+		{ErrUserNotMaster, codes.FailedPrecondition, "USER_NOT_MASTER"},
+		{ErrOrderNotInProgress, codes.FailedPrecondition, "ORDER_NOT_IN_PROGRESS"},
+		{ErrTransferNotPending, codes.FailedPrecondition, "TRANSFER_NOT_PENDING"},
+		{ErrTransferStale, codes.FailedPrecondition, "TRANSFER_STALE"},
+
+		{ErrVersionConflict, codes.Aborted, "VERSION_CONFLICT"},
+
+		{ErrUserServiceUnavailable, codes.Unavailable, "USER_SERVICE_UNAVAILABLE"},
+
+		{ErrGetOrderFailed, codes.Internal, "GET_ORDER_FAILED"},
+		{ErrGetAllOrderFailed, codes.Internal, "LIST_ORDERS_FAILED"},
+		{ErrCreateOrderFailed, codes.Internal, "CREATE_ORDER_FAILED"},
+		{ErrUpdateOrderFailed, codes.Internal, "UPDATE_ORDER_FAILED"},
+		{ErrRestoreOrderFailed, codes.Internal, "RESTORE_ORDER_FAILED"},
+		{ErrPurgeOrdersFailed, codes.Internal, "PURGE_ORDERS_FAILED"},
+		{ErrHardDeleteFailed, codes.Internal, "HARD_DELETE_FAILED"},
+		{ErrTransferFailed, codes.Internal, "TRANSFER_FAILED"},
+		{ErrGetTimelineFailed, codes.Internal, "GET_TIMELINE_FAILED"},
+		{ErrSearchFailed, codes.Internal, "SEARCH_FAILED"},
+		{ErrCreateOfferFailed, codes.Internal, "CREATE_OFFER_FAILED"},
+		{ErrGetOffersFailed, codes.Internal, "GET_OFFERS_FAILED"},
+		{ErrUpdateOfferFailed, codes.Internal, "UPDATE_OFFER_FAILED"},
+		{ErrGetEventsFailed, codes.Internal, "GET_EVENTS_FAILED"},
+	}
+	if len(tests) != len(domainErrors) {
+		t.Fatalf("test covers %d domain errors, domainErrors has %d", len(tests), len(domainErrors))
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			for _, err := range []error{tt.err, fmt.Errorf("обёртка: %w", tt.err)} {
+				st, info, br := statusDetails(t, ToStatus(err))
+				if st.Code() != tt.code {
+					t.Errorf("ToStatus(%v) code = %s, want %s", err, st.Code(), tt.code)
+				}
+				if st.Message() != err.Error() {
+					t.Errorf("ToStatus(%v) message = %q, want %q", err, st.Message(), err.Error())
+				}
+				if info.Reason != tt.reason {
+					t.Errorf("ToStatus(%v) reason = %q, want %q", err, info.Reason, tt.reason)
+				}
+				if len(info.Metadata) != 0 {
+					t.Errorf("ToStatus(%v) metadata = %v, want none", err, info.Metadata)
+				}
+				if br != nil {
+					t.Errorf("ToStatus(%v) has BadRequest, want none", err)
+				}
+			}
+		})
+	}
+}
+
+func TestToStatusTypedErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		code       codes.Code
+		reason     string
+		metadata   map[string]string
+		violations []string
+	}{
+		{
+			name:       "invalid field",
+			err:        invalidField("status", "нельзя назначить без исполнителя"),
+			code:       codes.InvalidArgument,
+			reason:     "INVALID_FIELD",
+			metadata:   map[string]string{"field": "status"},
+			violations: []string{"status: нельзя назначить без исполнителя"},
+		},
+		{
+			name: "validation",
+			err: &ValidationError{Violations: []FieldError{
+				{Field: "title", Message: "слишком короткое"},
+				{Field: "price", Message: "цена не может быть отрицательной"},
+			}},
+			code:       codes.InvalidArgument,
+			reason:     "VALIDATION_FAILED",
+			violations: []string{"title: слишком короткое", "price: цена не может быть отрицательной"},
+		},
+		{
+			name:     "invalid transition",
+			err:      &ErrInvalidTransition{From: order.StatusDone, To: order.StatusActive},
+			code:     codes.FailedPrecondition,
+			reason:   "INVALID_STATUS_TRANSITION",
+			metadata: map[string]string{"from": "done", "to": "active"},
+		},
+		{
+			name:   "bare status",
+			err:    status.Error(codes.NotFound, "нет"),
+			code:   codes.NotFound,
+			reason: "NotFound",
+		},
+		{
+			name:   "unknown",
+			err:    errors.New("boom"),
+			code:   codes.Internal,
+			reason: "INTERNAL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, info, br := statusDetails(t, ToStatus(tt.err))
+			if st.Code() != tt.code {
+				t.Errorf("code = %s, want %s", st.Code(), tt.code)
+			}
+			if info.Reason != tt.reason {
+				t.Errorf("reason = %q, want %q", info.Reason, tt.reason)
+			}
+			if !maps.Equal(info.Metadata, tt.metadata) {
+				t.Errorf("metadata = %v, want %v", info.Metadata, tt.metadata)
+			}
+			var violations []string
+			if br != nil {
+				for _, v := range br.FieldViolations {
+					violations = append(violations, v.Field+": "+v.Description)
+				}
+			}
+			if fmt.Sprint(violations) != fmt.Sprint(tt.violations) {
+				t.Errorf("violations = %v, want %v", violations, tt.violations)
+			}
+		})
+	}
+}
+
+func TestToStatusPassthrough(t *testing.T) {
+	if err := ToStatus(nil); err != nil {
+		t.Errorf("ToStatus(nil) = %v, want nil", err)
+	}
+	if got := status.Code(ToStatus(context.Canceled)); got != codes.Canceled {
+		t.Errorf("ToStatus(context.Canceled) code = %s, want Canceled", got)
+	}
+	if got := status.Code(ToStatus(context.DeadlineExceeded)); got != codes.DeadlineExceeded {
+		t.Errorf("ToStatus(context.DeadlineExceeded) code = %s, want DeadlineExceeded", got)
+	}
+
+	detailed := ToStatus(ErrOrderNotFound)
+	if got := ToStatus(detailed); got != detailed {
+		t.Errorf("ToStatus() of a detailed status = %v, want it unchanged", got)
+	}
+}
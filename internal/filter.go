@@ -0,0 +1,60 @@
+package order
+
+import (
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OrderFilter — условия отбора заказов. Пустые поля выборку не ограничивают.
+type OrderFilter struct {
+	ClientID    uuid.UUID
+	MasterID    uuid.UUID
+	CategoryIDs []uuid.UUID
+	Statuses    []order.Status
+	// Query — полнотекстовый запрос по названию, описанию и адресу.
+	Query string
+	// IncludeDeleted включает в выборку мягко удалённые заказы.
+	IncludeDeleted bool
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	MinPrice       float32
+	MaxPrice       float32
+}
+
+func (f OrderFilter) predicates() []predicate.Order {
+	var ps []predicate.Order
+	if !f.IncludeDeleted {
+		ps = append(ps, order.DeletedAtIsNil())
+	}
+	if f.ClientID != uuid.Nil {
+		ps = append(ps, order.ClientIDEQ(f.ClientID))
+	}
+	if f.MasterID != uuid.Nil {
+		ps = append(ps, order.MasterIDEQ(f.MasterID))
+	}
+	if len(f.CategoryIDs) > 0 {
+		ps = append(ps, order.CategoryIDIn(f.CategoryIDs...))
+	}
+	if len(f.Statuses) > 0 {
+		ps = append(ps, order.StatusIn(f.Statuses...))
+	}
+	if f.Query != "" {
+		ps = append(ps, matchesSearch(f.Query))
+	}
+	if !f.CreatedAfter.IsZero() {
+		ps = append(ps, order.CreatedAtGTE(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		ps = append(ps, order.CreatedAtLT(f.CreatedBefore))
+	}
+	if f.MinPrice > 0 {
+		ps = append(ps, order.PriceGTE(f.MinPrice))
+	}
+	if f.MaxPrice > 0 {
+		ps = append(ps, order.PriceLTE(f.MaxPrice))
+	}
+	return ps
+}
@@ -0,0 +1,139 @@
+package order
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+const (
+	earthRadiusMeters = 6371000.0
+	// metersPerDegree — длина одного градуса широты в метрах.
+	metersPerDegree = 111320.0
+
+	MaxNearbyRadius = 100000.0
+	MaxNearbyLimit  = 200
+)
+
+var (
+	ErrInvalidCoordinates = errors.New("неправильные координаты")
+	ErrInvalidRadius      = errors.New("неправильный радиус поиска")
+)
+
+// NearbyOrder — заказ с расстоянием до точки поиска в метрах.
+type NearbyOrder struct {
+	Order    *ent.Order
+	Distance float64
+}
+
+// parseCoordinate разбирает координату из строки API. Десятичным разделителем
+// может быть как точка, так и запятая.
+func parseCoordinate(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseFloat(commaToDot(s), 64)
+	if err != nil {
+		return 0, ErrInvalidCoordinates
+	}
+	return v, nil
+}
+
+func commaToDot(s string) string {
+	b := []byte(s)
+	for i := range b {
+		if b[i] == ',' {
+			b[i] = '.'
+		}
+	}
+	return string(b)
+}
+
+// FormatCoordinate форматирует координату для ответа API.
+func FormatCoordinate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func validateCoordinates(longitude, latitude float64) error {
+	if math.IsNaN(longitude) || math.IsNaN(latitude) ||
+		longitude < -180 || longitude > 180 ||
+		latitude < -90 || latitude > 90 {
+		return ErrInvalidCoordinates
+	}
+	return nil
+}
+
+// haversine возвращает расстояние между двумя точками в метрах.
+func haversine(lon1, lat1, lon2, lat2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLon := (lon2 - lon1) * math.Pi / 180
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// distanceSQL пишет в b SQL-выражение расстояния (в метрах) от заказа до точки по формуле гаверсинусов.
+func distanceSQL(b *sql.Builder, s *sql.Selector, longitude, latitude float64) {
+	lat, lon := s.C(order.FieldLatitude), s.C(order.FieldLongitude)
+	b.WriteString(strconv.FormatFloat(2*earthRadiusMeters, 'f', -1, 64)).
+		WriteString(" * asin(least(1, sqrt(power(sin(radians(").WriteString(lat).WriteString(" - ").Arg(latitude).
+		WriteString(") / 2), 2) + cos(radians(").Arg(latitude).WriteString(")) * cos(radians(").WriteString(lat).
+		WriteString(")) * power(sin(radians(").WriteString(lon).WriteString(" - ").Arg(longitude).
+		WriteString(") / 2), 2))))")
+}
+
+// withinRadius отбирает заказы не дальше radius метров от точки.
+// Сначала отсекаем по ограничивающему прямоугольнику (использует индекс),
+// затем проверяем точное расстояние.
+func withinRadius(longitude, latitude, radius float64) predicate.Order {
+	dLat := radius / metersPerDegree
+	dLon := 180.0
+	if cos := math.Cos(latitude * math.Pi / 180); cos > 0.01 {
+		dLon = math.Min(180, dLat/cos)
+	}
+	box := []predicate.Order{
+		order.LatitudeGTE(latitude - dLat),
+		order.LatitudeLTE(latitude + dLat),
+	}
+	if dLon < 180 {
+		var ranges []predicate.Order
+		for _, r := range longitudeRanges(longitude, dLon) {
+			ranges = append(ranges, order.And(order.LongitudeGTE(r[0]), order.LongitudeLTE(r[1])))
+		}
+		box = append(box, order.Or(ranges...))
+	}
+	return order.And(append(box, func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			distanceSQL(b, s, longitude, latitude)
+			b.WriteString(" <= ").Arg(radius)
+		}))
+	})...)
+}
+
+// longitudeRanges возвращает диапазоны долгот [min, max] в пределах ±dLon от
+// longitude. Если окно переходит через антимеридиан, оно делится на два
+// диапазона по обе стороны от ±180°.
+func longitudeRanges(longitude, dLon float64) [][2]float64 {
+	lo, hi := longitude-dLon, longitude+dLon
+	switch {
+	case lo < -180:
+		return [][2]float64{{lo + 360, 180}, {-180, hi}}
+	case hi > 180:
+		return [][2]float64{{lo, 180}, {-180, hi - 360}}
+	}
+	return [][2]float64{{lo, hi}}
+}
+
+// byDistance сортирует заказы по удалённости от точки.
+func byDistance(longitude, latitude float64) order.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			distanceSQL(b, s, longitude, latitude)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package order
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLongitudeRanges(t *testing.T) {
+	tests := []struct {
+		name            string
+		longitude, dLon float64
+		want            [][2]float64
+	}{
+		{"inside", 37.5, 0.5, [][2]float64{{37, 38}}},
+		{"touches 180", 179.5, 0.5, [][2]float64{{179, 180}}},
+		{"crosses east", 179.5, 1, [][2]float64{{178.5, 180}, {-180, -179.5}}},
+		{"crosses west", -179.75, 0.5, [][2]float64{{179.75, 180}, {-180, -179.25}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := longitudeRanges(tt.longitude, tt.dLon); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("longitudeRanges(%v, %v) = %v, want %v", tt.longitude, tt.dLon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHaversineAcrossAntimeridian(t *testing.T) {
+	// Точки по разные стороны от 180° на экваторе: между ними 0,2° долготы.
+	got := haversine(179.9, 0, -179.9, 0)
+	want := 0.2 * math.Pi / 180 * earthRadiusMeters
+	if math.Abs(got-want) > 1 {
+		t.Errorf("haversine() = %.1f m, want %.1f m", got, want)
+	}
+}
+
+func TestParseCoordinate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+		ok   bool
+	}{
+		{"", 0, true},
+		{"37.6173", 37.6173, true},
+		{"37,6173", 37.6173, true},
+		{"-179.9", -179.9, true},
+		{"east", 0, false},
+		{"1.2.3", 0, false},
+	}
+	for _, tt := range tests {
+		got, err := parseCoordinate(tt.in)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("parseCoordinate(%q) = %v, %v", tt.in, got, err)
+		}
+	}
+}
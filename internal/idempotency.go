@@ -0,0 +1,86 @@
+package order
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/idempotencykey"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKeyHeader — ключ метаданных с ключом идемпотентности запроса.
+const IdempotencyKeyHeader = "x-idempotency-key"
+
+const MaxIdempotencyKeyLength = 255
+
+var (
+	ErrInvalidIdempotencyKey = errors.New("неправильный ключ идемпотентности")
+	ErrIdempotencyKeyReused  = errors.New("ключ идемпотентности уже использован для другого запроса")
+)
+
+// idempotencyKeyFromContext читает ключ идемпотентности из метаданных запроса.
+// Пустая строка означает, что ключ не передан.
+func idempotencyKeyFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	vals := md.Get(IdempotencyKeyHeader)
+	if len(vals) == 0 || vals[0] == "" {
+		return "", nil
+	}
+	if len(vals[0]) > MaxIdempotencyKeyLength {
+		return "", ErrInvalidIdempotencyKey
+	}
+	return vals[0], nil
+}
+
+// requestFingerprint возвращает отпечаток запроса: SHA-256 его детерминированной
+// сериализации. Одинаковые запросы дают одинаковый отпечаток.
+func requestFingerprint(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayCreate возвращает заказ, ранее созданный клиентом client_id с ключом
+// idempotency_key, или nil, если такого ключа нет. Если ключ использовался с
+// другим отпечатком запроса, возвращается ErrIdempotencyKeyReused, а если
+// заказ с тех пор удалён — ErrOrderNotFound.
+func (r *repo) replayCreate(ctx context.Context, client_id uuid.UUID, idempotency_key, fingerprint string) (*ent.Order, error) {
+	k, err := r.client.IdempotencyKey.Query().
+		Where(
+			idempotencykey.ClientIDEQ(client_id),
+			idempotencykey.KeyEQ(idempotency_key),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, ErrCreateOrderFailed
+	}
+	if k.Fingerprint != fingerprint {
+		return nil, ErrIdempotencyKeyReused
+	}
+	// Заказ, созданный по ключу, мог быть удалён: повтор запроса не должен его вернуть.
+	o, err := r.client.Order.Query().
+		Where(order.IDEQ(k.OrderID), order.DeletedAtIsNil()).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrCreateOrderFailed
+	}
+	return o, nil
+}
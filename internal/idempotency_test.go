@@ -0,0 +1,119 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+)
+
+func TestRequestFingerprint(t *testing.T) {
+	req := func() *orderpbv1.CreateOrderRequest {
+		return &orderpbv1.CreateOrderRequest{Title: "Собрать шкаф", Address: "Москва, ул. Ленина, 1", Price: 3000}
+	}
+	fp := func(r *orderpbv1.CreateOrderRequest) string {
+		t.Helper()
+		s, err := requestFingerprint(r)
+		if err != nil {
+			t.Fatalf("requestFingerprint() error = %v", err)
+		}
+		return s
+	}
+
+	base := fp(req())
+	if len(base) != 64 {
+		t.Errorf("fingerprint %q, want hex SHA-256", base)
+	}
+	if again := fp(req()); again != base {
+		t.Errorf("same request fingerprints differ: %s != %s", again, base)
+	}
+	changed := req()
+	changed.Price = 3001
+	if fp(changed) == base {
+		t.Error("different requests have the same fingerprint")
+	}
+}
+
+// newMockRepo возвращает repo поверх sqlmock.
+func newMockRepo(t *testing.T) (*repo, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("postgres", db)))
+	return &repo{client: client}, mock
+}
+
+func expectIdempotencyKey(mock sqlmock.Sqlmock, client_id, order_id uuid.UUID, key, fingerprint string) {
+	mock.ExpectQuery(`FROM "idempotency_keys"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key", "client_id", "fingerprint", "created_at", "order_id"}).
+			AddRow(uuid.New(), key, client_id, fingerprint, time.Now(), order_id))
+}
+
+func orderRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "title", "description", "price", "address", "longitude", "latitude",
+		"category_id", "client_id", "master_id", "status", "version", "created_at", "updated_at", "deleted_at"})
+}
+
+func TestReplayCreate(t *testing.T) {
+	client_id, order_id := uuid.New(), uuid.New()
+	const key, fingerprint = "retry-1", "fp-1"
+
+	t.Run("new key", func(t *testing.T) {
+		r, mock := newMockRepo(t)
+		mock.ExpectQuery(`FROM "idempotency_keys"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		o, err := r.replayCreate(context.Background(), client_id, key, fingerprint)
+		if o != nil || err != nil {
+			t.Errorf("replayCreate() = %v, %v, want nil, nil", o, err)
+		}
+	})
+
+	t.Run("same request is replayed", func(t *testing.T) {
+		r, mock := newMockRepo(t)
+		expectIdempotencyKey(mock, client_id, order_id, key, fingerprint)
+		now := time.Now()
+		mock.ExpectQuery(`FROM "orders" WHERE "orders"."id" = \$1 AND "orders"."deleted_at" IS NULL`).
+			WithArgs(order_id).
+			WillReturnRows(orderRows().AddRow(order_id, "Шкаф", "", 3000, "Москва, ул. Ленина, 1", 37.6, 55.7,
+				uuid.New(), client_id, nil, "active", 1, now, now, nil))
+		o, err := r.replayCreate(context.Background(), client_id, key, fingerprint)
+		if err != nil || o == nil || o.ID != order_id {
+			t.Errorf("replayCreate() = %v, %v, want order %s", o, err, order_id)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("key reused with another body", func(t *testing.T) {
+		r, mock := newMockRepo(t)
+		expectIdempotencyKey(mock, client_id, order_id, key, fingerprint)
+		_, err := r.replayCreate(context.Background(), client_id, key, "fp-2")
+		if !errors.Is(err, ErrIdempotencyKeyReused) {
+			t.Errorf("replayCreate() error = %v, want ErrIdempotencyKeyReused", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("order deleted since", func(t *testing.T) {
+		r, mock := newMockRepo(t)
+		expectIdempotencyKey(mock, client_id, order_id, key, fingerprint)
+		mock.ExpectQuery(`FROM "orders" WHERE "orders"."id" = \$1 AND "orders"."deleted_at" IS NULL`).
+			WillReturnRows(orderRows())
+		_, err := r.replayCreate(context.Background(), client_id, key, fingerprint)
+		if !errors.Is(err, ErrOrderNotFound) {
+			t.Errorf("replayCreate() error = %v, want ErrOrderNotFound", err)
+		}
+	})
+}
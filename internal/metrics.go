@@ -0,0 +1,171 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// metricsScrapeTimeout — сколько ждать подсчёта заказов при сборе метрик.
+const metricsScrapeTimeout = 5 * time.Second
+
+// Metrics — метрики сервиса заказов в формате Prometheus.
+type Metrics struct {
+	rpcDuration *prometheus.HistogramVec
+	rpcTotal    *prometheus.CounterVec
+
+	userDuration *prometheus.HistogramVec
+
+	events    *prometheus.CounterVec
+	created   prometheus.Counter
+	completed prometheus.Counter
+}
+
+// NewMetrics создаёт метрики и регистрирует их в reg вместе с числом заказов
+// из repo, которое подсчитывается при каждом сборе метрик.
+func NewMetrics(reg prometheus.Registerer, repo Repoistory) *Metrics {
+	m := &Metrics{
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Время обработки RPC сервером.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method"}),
+		rpcTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Число обработанных RPC по методам и кодам ответа.",
+		}, []string{"method", "code"}),
+		userDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "user_client_handling_seconds",
+			Help:    "Время вызовов сервиса пользователей по методам и кодам ответа.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "code"}),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "orders_events_total",
+			Help: "Число опубликованных событий заказов по типам.",
+		}, []string{"type"}),
+		created: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "orders_created_total",
+			Help: "Число созданных заказов.",
+		}),
+		completed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "orders_completed_total",
+			Help: "Число выполненных заказов.",
+		}),
+	}
+	reg.MustRegister(
+		m.rpcDuration, m.rpcTotal, m.userDuration,
+		m.events, m.created, m.completed,
+		&orderCountCollector{repo: repo},
+	)
+	return m
+}
+
+// UnaryServerInterceptor измеряет время и коды ответа unary-RPC. В цепочке
+// должен стоять перед UnaryErrorInterceptor, чтобы видеть итоговый код ответа.
+func (m *Metrics) UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observe(info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamServerInterceptor измеряет время и коды ответа потоковых RPC.
+func (m *Metrics) StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observe(info.FullMethod, start, err)
+	return err
+}
+
+func (m *Metrics) observe(method string, start time.Time, err error) {
+	m.rpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	m.rpcTotal.WithLabelValues(method, status.Code(err).String()).Inc()
+}
+
+// UserClientInterceptor измеряет время отдельных вызовов сервиса пользователей.
+func (m *Metrics) UserClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	m.userDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return err
+}
+
+// Publish считает события заказов. Подключается к Relay последним в
+// MultiPublisher, чтобы событие не учитывалось повторно при ошибке публикации.
+func (m *Metrics) Publish(ctx context.Context, event *ent.OutboxEvent) error {
+	m.events.WithLabelValues(event.Type.String()).Inc()
+	switch event.Type {
+	case EventOrderCreated:
+		m.created.Inc()
+	case EventOrderStatusChanged:
+		var ev OrderEvent
+		if err := json.Unmarshal(event.Payload, &ev); err != nil {
+			log.Printf("metrics: bad payload of event %s: %v", event.ID, err)
+			return nil
+		}
+		if ev.Status == "done" {
+			m.completed.Inc()
+		}
+	}
+	return nil
+}
+
+// orderCountCollector отдаёт число неудалённых заказов по статусам и категориям.
+type orderCountCollector struct {
+	repo Repoistory
+}
+
+var ordersDesc = prometheus.NewDesc(
+	"orders",
+	"Число неудалённых заказов по статусам и категориям.",
+	[]string{"status", "category_id"}, nil,
+)
+
+func (c *orderCountCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- ordersDesc
+}
+
+func (c *orderCountCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsScrapeTimeout)
+	defer cancel()
+	counts, err := c.repo.CountByStatusCategory(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(ordersDesc, err)
+		return
+	}
+	for _, oc := range counts {
+		ch <- prometheus.MustNewConstMetric(ordersDesc, prometheus.GaugeValue, float64(oc.Count), oc.Status, oc.CategoryID.String())
+	}
+}
+
+// RegisterUserClient регистрирует в reg счётчики клиента сервиса пользователей c.
+func (m *Metrics) RegisterUserClient(reg prometheus.Registerer, c *ResilientUserClient) {
+	stats := c.Stats()
+	counter := func(name, help string, v func() int64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, func() float64 {
+			return float64(v())
+		})
+	}
+	reg.MustRegister(
+		counter("user_client_calls_total", "Число вызовов сервиса пользователей, включая повторы.", stats.Calls.Load),
+		counter("user_client_retries_total", "Число повторных вызовов сервиса пользователей.", stats.Retries.Load),
+		counter("user_client_failures_total", "Число вызовов сервиса пользователей, завершившихся временной ошибкой после всех попыток.", stats.Failures.Load),
+		counter("user_client_rejected_total", "Число вызовов, отклонённых разомкнутым выключателем.", stats.Rejected.Load),
+		counter("user_client_breaker_opens_total", "Сколько раз размыкался выключатель сервиса пользователей.", stats.BreakerOpens.Load),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "user_client_breaker_open",
+			Help: "1, если выключатель сервиса пользователей разомкнут.",
+		}, func() float64 {
+			if c.BreakerOpen() {
+				return 1
+			}
+			return 0
+		}),
+	)
+}
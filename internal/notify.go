@@ -0,0 +1,85 @@
+package order
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// NotifyChannel — канал PostgreSQL LISTEN/NOTIFY, в который публикуются ID событий заказов.
+const NotifyChannel = "order_events"
+
+// NotifyPublisher рассылает ID событий через PostgreSQL NOTIFY, чтобы все экземпляры
+// сервиса получили изменения, а не только тот, чей Relay забрал событие.
+type NotifyPublisher struct {
+	repo Repoistory
+}
+
+func NewNotifyPublisher(repo Repoistory) *NotifyPublisher {
+	return &NotifyPublisher{repo: repo}
+}
+
+func (p *NotifyPublisher) Publish(ctx context.Context, event *ent.OutboxEvent) error {
+	return p.repo.NotifyEvent(ctx, NotifyChannel, event.ID)
+}
+
+// Listener слушает NotifyChannel, загружает события из outbox и передаёт их в Publisher
+// (обычно локальный Broadcaster).
+type Listener struct {
+	dsn  string
+	repo Repoistory
+	pub  Publisher
+}
+
+func NewListener(dsn string, repo Repoistory, pub Publisher) *Listener {
+	return &Listener{dsn: dsn, repo: repo, pub: pub}
+}
+
+// Run слушает уведомления до отмены ctx.
+func (l *Listener) Run(ctx context.Context) error {
+	listener := pq.NewListener(l.dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("order events listener: %v", err)
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(NotifyChannel); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-listener.Notify:
+			// nil приходит после переподключения: пропущенные уведомления не восстанавливаются
+			if n == nil {
+				continue
+			}
+			l.handle(ctx, n.Extra)
+		case <-time.After(90 * time.Second):
+			go listener.Ping()
+		}
+	}
+}
+
+func (l *Listener) handle(ctx context.Context, payload string) {
+	id, err := uuid.Parse(payload)
+	if err != nil {
+		log.Printf("order events listener: bad payload %q", payload)
+		return
+	}
+	event, err := l.repo.GetEvent(ctx, id)
+	if err != nil {
+		log.Printf("order events listener: get event %s: %v", id, err)
+		return
+	}
+	if err := l.pub.Publish(ctx, event); err != nil {
+		log.Printf("order events listener: publish event %s: %v", id, err)
+	}
+}
@@ -0,0 +1,238 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
+	"github.com/google/uuid"
+)
+
+var ErrGetEventsFailed = errors.New("ошибка получения событий для публикации")
+
+// EventType — тип доменного события заказа.
+type EventType = outboxevent.Type
+
+const (
+	EventOrderCreated       = outboxevent.TypeOrderCreated
+	EventOrderUpdated       = outboxevent.TypeOrderUpdated
+	EventOrderAssigned      = outboxevent.TypeOrderAssigned
+	EventOrderStatusChanged = outboxevent.TypeOrderStatusChanged
+	EventOrderDeleted       = outboxevent.TypeOrderDeleted
+	EventOrderRestored      = outboxevent.TypeOrderRestored
+	EventOrderUnassigned    = outboxevent.TypeOrderUnassigned
+	// EventTransferRequested и EventTransferDeclined адресованы обоим исполнителям
+	// передачи: текущему (MasterID) и предложенному (TransferMasterID).
+	EventTransferRequested = outboxevent.TypeMasterTransferRequested
+	EventTransferDeclined  = outboxevent.TypeMasterTransferDeclined
+)
+
+// OrderEvent — данные доменного события заказа, сохраняемые в payload.
+type OrderEvent struct {
+	OrderID        uuid.UUID  `json:"order_id"`
+	ClientID       uuid.UUID  `json:"client_id"`
+	MasterID       *uuid.UUID `json:"master_id,omitempty"`
+	CategoryID     uuid.UUID  `json:"category_id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	Address        string     `json:"address"`
+	Longitude      float64    `json:"longitude"`
+	Latitude       float64    `json:"latitude"`
+	Price          float32    `json:"price"`
+	Status         string     `json:"status"`
+	PreviousStatus string     `json:"previous_status,omitempty"`
+	// PreviousMasterID — исполнитель до переназначения.
+	PreviousMasterID *uuid.UUID `json:"previous_master_id,omitempty"`
+	// TransferMasterID — исполнитель, которому предложена передача заказа.
+	TransferMasterID *uuid.UUID `json:"transfer_master_id,omitempty"`
+	ActorID          *uuid.UUID `json:"actor_id,omitempty"`
+	// Reason — причина действия, если она указана.
+	Reason     string    `json:"reason,omitempty"`
+	Version    int       `json:"version"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// recordEvent записывает событие о заказе o в outbox в рамках транзакции tx.
+func recordEvent(ctx context.Context, tx *ent.Tx, typ EventType, o *ent.Order, previous *order.Status, actor_id uuid.UUID) error {
+	return insertEvent(ctx, tx, typ, newOrderEvent(o, previous, actor_id))
+}
+
+// newOrderEvent заполняет данные события по заказу o.
+func newOrderEvent(o *ent.Order, previous *order.Status, actor_id uuid.UUID) *OrderEvent {
+	ev := &OrderEvent{
+		OrderID:     o.ID,
+		ClientID:    o.ClientID,
+		CategoryID:  o.CategoryID,
+		Title:       o.Title,
+		Description: o.Description,
+		Address:     o.Address,
+		Longitude:   o.Longitude,
+		Latitude:    o.Latitude,
+		Price:       o.Price,
+		Status:      o.Status.String(),
+		Version:     o.Version,
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+		OccurredAt:  time.Now(),
+	}
+	if o.MasterID != uuid.Nil {
+		ev.MasterID = &o.MasterID
+	}
+	if previous != nil {
+		ev.PreviousStatus = previous.String()
+	}
+	if actor_id != uuid.Nil {
+		ev.ActorID = &actor_id
+	}
+	return ev
+}
+
+// insertEvent сохраняет событие ev в outbox в рамках транзакции tx.
+func insertEvent(ctx context.Context, tx *ent.Tx, typ EventType, ev *OrderEvent) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return tx.OutboxEvent.Create().
+		SetOrderID(ev.OrderID).
+		SetType(typ).
+		SetPayload(payload).
+		Exec(ctx)
+}
+
+// recordUpdateEvents записывает события об изменении заказа с before на after.
+func recordUpdateEvents(ctx context.Context, tx *ent.Tx, before, after *ent.Order, actor_id uuid.UUID) error {
+	if after.Status != before.Status {
+		return recordEvent(ctx, tx, EventOrderStatusChanged, after, &before.Status, actor_id)
+	}
+	return recordEvent(ctx, tx, EventOrderUpdated, after, nil, actor_id)
+}
+
+// Publisher доставляет события заказов внешним потребителям.
+// Publish может вызываться для одного события несколько раз (at-least-once),
+// потребители должны дедуплицировать события по ID.
+type Publisher interface {
+	Publish(ctx context.Context, event *ent.OutboxEvent) error
+}
+
+// LogPublisher пишет события в лог. Используется, пока не настроен брокер сообщений.
+// Содержимое события в лог не попадает: в нём адреса и координаты клиентов.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(ctx context.Context, event *ent.OutboxEvent) error {
+	log.Printf("order event %s %s order=%s", event.ID, event.Type, event.OrderID)
+	return nil
+}
+
+// MultiPublisher публикует событие через все Publisher по очереди и
+// останавливается на первой ошибке.
+type MultiPublisher []Publisher
+
+func (m MultiPublisher) Publish(ctx context.Context, event *ent.OutboxEvent) error {
+	for _, p := range m {
+		if err := p.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	DefaultRelayInterval    = time.Second
+	DefaultRelayBatchSize   = 100
+	DefaultRelayMaxAttempts = 10
+
+	// relayLease — на сколько событие резервируется за экземпляром, забравшим его на отправку.
+	relayLease     = time.Minute
+	relayBaseDelay = time.Second
+	relayMaxDelay  = 10 * time.Minute
+)
+
+// Relay периодически забирает неотправленные события из outbox и публикует их через Publisher.
+// После MaxAttempts неудачных попыток событие переводится в статус dead.
+type Relay struct {
+	repo        Repoistory
+	pub         Publisher
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+}
+
+func NewRelay(repo Repoistory, pub Publisher) *Relay {
+	return &Relay{
+		repo:        repo,
+		pub:         pub,
+		Interval:    DefaultRelayInterval,
+		BatchSize:   DefaultRelayBatchSize,
+		MaxAttempts: DefaultRelayMaxAttempts,
+	}
+}
+
+// Run публикует события до отмены ctx.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Пока события идут полными пачками, разбираем их без паузы
+		for {
+			n, err := r.relayBatch(ctx)
+			if err != nil {
+				log.Printf("outbox relay: %v", err)
+				break
+			}
+			if n < r.BatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	events, err := r.repo.ClaimEvents(ctx, r.BatchSize, relayLease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ev := range events {
+		if err := r.pub.Publish(ctx, ev); err != nil {
+			attempts := ev.Attempts + 1
+			dead := attempts >= r.MaxAttempts
+			if dead {
+				log.Printf("outbox relay: event %s moved to dead letter after %d attempts: %v", ev.ID, attempts, err)
+			}
+			if merr := r.repo.MarkEventFailed(ctx, ev.ID, err.Error(), time.Now().Add(backoff(attempts)), dead); merr != nil {
+				log.Printf("outbox relay: mark event %s failed: %v", ev.ID, merr)
+			}
+			continue
+		}
+		if err := r.repo.MarkEventPublished(ctx, ev.ID); err != nil {
+			log.Printf("outbox relay: mark event %s published: %v", ev.ID, err)
+		}
+	}
+
+	return len(events), nil
+}
+
+// backoff возвращает задержку перед следующей попыткой: экспоненциально растёт от relayBaseDelay до relayMaxDelay.
+func backoff(attempts int) time.Duration {
+	d := time.Duration(float64(relayBaseDelay) * math.Pow(2, float64(attempts-1)))
+	if d <= 0 || d > relayMaxDelay {
+		return relayMaxDelay
+	}
+	return d
+}
@@ -0,0 +1,136 @@
+package order
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 500
+)
+
+var (
+	ErrInvalidCursor   = errors.New("неправильный курсор страницы")
+	ErrInvalidSort     = errors.New("неизвестный порядок сортировки")
+	ErrInvalidPageSize = errors.New("неправильный размер страницы")
+)
+
+// SortOrder — порядок сортировки списка заказов.
+type SortOrder string
+
+const (
+	SortNewest    SortOrder = "newest"
+	SortOldest    SortOrder = "oldest"
+	SortPriceAsc  SortOrder = "price_asc"
+	SortPriceDesc SortOrder = "price_desc"
+)
+
+// ParseSortOrder разбирает порядок сортировки; пустая строка означает SortNewest.
+func ParseSortOrder(s string) (SortOrder, error) {
+	switch SortOrder(s) {
+	case "":
+		return SortNewest, nil
+	case SortNewest, SortOldest, SortPriceAsc, SortPriceDesc:
+		return SortOrder(s), nil
+	}
+	return "", ErrInvalidSort
+}
+
+// PageRequest — параметры запрашиваемой страницы.
+type PageRequest struct {
+	Size   int
+	Cursor string
+	Sort   SortOrder
+}
+
+// OrdersPage — страница заказов с курсором на следующую страницу.
+// NextCursor пуст, если страница последняя.
+type OrdersPage struct {
+	Orders     []*ent.Order
+	NextCursor string
+	Total      int
+}
+
+// cursor — позиция последнего заказа страницы в порядке сортировки.
+type cursor struct {
+	Sort      SortOrder `json:"s"`
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+	Price     float32   `json:"p,omitempty"`
+}
+
+func encodeCursor(sort SortOrder, o *ent.Order) string {
+	b, _ := json.Marshal(cursor{Sort: sort, CreatedAt: o.CreatedAt, ID: o.ID, Price: o.Price})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string, sort SortOrder) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	// Курсор приходит от клиента: без позиции он не указывает ни на один заказ.
+	if c.Sort != sort || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// normalize подставляет значения по умолчанию и ограничивает размер страницы.
+func (p PageRequest) normalize() (PageRequest, error) {
+	sort, err := ParseSortOrder(string(p.Sort))
+	if err != nil {
+		return p, err
+	}
+	p.Sort = sort
+	if p.Size <= 0 {
+		p.Size = DefaultPageSize
+	}
+	if p.Size > MaxPageSize {
+		p.Size = MaxPageSize
+	}
+	return p, nil
+}
+
+// orderTerms возвращает сортировку заказов, согласованную с keyset-условием after.
+func (p PageRequest) orderTerms() []order.OrderOption {
+	switch p.Sort {
+	case SortOldest:
+		return []order.OrderOption{order.ByCreatedAt(), order.ByID()}
+	case SortPriceAsc:
+		return []order.OrderOption{order.ByPrice(), order.ByCreatedAt(), order.ByID()}
+	case SortPriceDesc:
+		return []order.OrderOption{order.ByPrice(sql.OrderDesc()), order.ByCreatedAt(sql.OrderDesc()), order.ByID(sql.OrderDesc())}
+	default:
+		return []order.OrderOption{order.ByCreatedAt(sql.OrderDesc()), order.ByID(sql.OrderDesc())}
+	}
+}
+
+// after возвращает предикат, отбирающий заказы, идущие после курсора c.
+func (p PageRequest) after(c *cursor) predicate.Order {
+	return func(s *sql.Selector) {
+		switch p.Sort {
+		case SortOldest:
+			s.Where(sql.CompositeGT([]string{s.C(order.FieldCreatedAt), s.C(order.FieldID)}, c.CreatedAt, c.ID))
+		case SortPriceAsc:
+			s.Where(sql.CompositeGT([]string{s.C(order.FieldPrice), s.C(order.FieldCreatedAt), s.C(order.FieldID)}, c.Price, c.CreatedAt, c.ID))
+		case SortPriceDesc:
+			s.Where(sql.CompositeLT([]string{s.C(order.FieldPrice), s.C(order.FieldCreatedAt), s.C(order.FieldID)}, c.Price, c.CreatedAt, c.ID))
+		default:
+			s.Where(sql.CompositeLT([]string{s.C(order.FieldCreatedAt), s.C(order.FieldID)}, c.CreatedAt, c.ID))
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package order
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	o := &ent.Order{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2026, 10, 18, 9, 30, 15, 123456789, time.UTC),
+		Price:     1500.5,
+	}
+	for _, sort := range []SortOrder{SortNewest, SortOldest, SortPriceAsc, SortPriceDesc} {
+		t.Run(string(sort), func(t *testing.T) {
+			c, err := decodeCursor(encodeCursor(sort, o), sort)
+			if err != nil {
+				t.Fatalf("decodeCursor() error = %v", err)
+			}
+			if c.Sort != sort || c.ID != o.ID || !c.CreatedAt.Equal(o.CreatedAt) || c.Price != o.Price {
+				t.Errorf("decodeCursor() = %+v, want position of %+v", c, o)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	valid := encodeCursor(SortNewest, &ent.Order{ID: uuid.New(), CreatedAt: time.Now()})
+	raw := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+
+	tests := []struct {
+		name  string
+		token string
+		sort  SortOrder
+	}{
+		{"not base64", "!!!", SortNewest},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"s":"newest"}`)), SortNewest},
+		{"not json", raw("newest:123"), SortNewest},
+		{"wrong types", raw(`{"s":"newest","c":1,"i":"x"}`), SortNewest},
+		{"other sort", valid, SortPriceAsc},
+		{"tampered sort", raw(`{"s":"price_asc","c":"2026-10-18T09:00:00Z","i":"` + uuid.NewString() + `"}`), SortNewest},
+		{"no id", raw(`{"s":"newest","c":"2026-10-18T09:00:00Z"}`), SortNewest},
+		{"no created_at", raw(`{"s":"newest","i":"` + uuid.NewString() + `"}`), SortNewest},
+		{"empty object", raw(`{}`), SortNewest},
+		{"truncated", valid[:len(valid)/2], SortNewest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.token, tt.sort); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("decodeCursor(%q) error = %v, want ErrInvalidCursor", tt.token, err)
+			}
+		})
+	}
+}
+
+func TestParseSortOrder(t *testing.T) {
+	tests := []struct {
+		in   string
+		want SortOrder
+		err  error
+	}{
+		{"", SortNewest, nil},
+		{"newest", SortNewest, nil},
+		{"oldest", SortOldest, nil},
+		{"price_asc", SortPriceAsc, nil},
+		{"price_desc", SortPriceDesc, nil},
+		{"Newest", "", ErrInvalidSort},
+		{"price", "", ErrInvalidSort},
+	}
+	for _, tt := range tests {
+		got, err := ParseSortOrder(tt.in)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("ParseSortOrder(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestPageRequestNormalize(t *testing.T) {
+	tests := []struct {
+		in   PageRequest
+		want PageRequest
+	}{
+		{PageRequest{}, PageRequest{Size: DefaultPageSize, Sort: SortNewest}},
+		{PageRequest{Size: 10, Sort: SortOldest}, PageRequest{Size: 10, Sort: SortOldest}},
+		{PageRequest{Size: MaxPageSize + 1}, PageRequest{Size: MaxPageSize, Sort: SortNewest}},
+	}
+	for _, tt := range tests {
+		got, err := tt.in.normalize()
+		if err != nil || got != tt.want {
+			t.Errorf("%+v.normalize() = %+v, %v, want %+v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := (PageRequest{Sort: "random"}).normalize(); !errors.Is(err, ErrInvalidSort) {
+		t.Errorf("normalize() with unknown sort error = %v, want ErrInvalidSort", err)
+	}
+}
+
+func TestPageFromContext(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want PageRequest
+		err  error
+	}{
+		{"no metadata", nil, PageRequest{}, nil},
+		{"all keys", metadata.Pairs("x-page-size", "20", "x-page-token", "abc", "x-sort", "price_desc"),
+			PageRequest{Size: 20, Cursor: "abc", Sort: SortPriceDesc}, nil},
+		{"zero size", metadata.Pairs("x-page-size", "0"), PageRequest{}, nil},
+		{"negative size", metadata.Pairs("x-page-size", "-1"), PageRequest{}, ErrInvalidPageSize},
+		{"size not a number", metadata.Pairs("x-page-size", "ten"), PageRequest{}, ErrInvalidPageSize},
+		{"unknown sort", metadata.Pairs("x-sort", "cheapest"), PageRequest{}, ErrInvalidSort},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			got, err := pageFromContext(ctx)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("pageFromContext() error = %v, want %v", err, tt.err)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("pageFromContext() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Пути маски обновления заказа — имена полей UpdateOrderRequest.
+const (
+	PathTitle       = "title"
+	PathDescription = "description"
+	PathAddress     = "address"
+	PathLongitude   = "longitude"
+	PathLatitude    = "latitude"
+	PathStatus      = "status"
+	PathPrice       = "price"
+	PathCategoryID  = "category_id"
+	PathMasterID    = "master_id"
+)
+
+var (
+	// updatablePaths — поля, которые можно указать в маске обновления.
+	updatablePaths = map[string]bool{
+		PathTitle: true, PathDescription: true, PathAddress: true,
+		PathLongitude: true, PathLatitude: true, PathStatus: true,
+		PathPrice: true, PathCategoryID: true,
+	}
+	// immutablePaths — поля заказа, которые не меняются после создания.
+	immutablePaths = map[string]bool{
+		"id": true, "client_id": true, "created_at": true, "updated_at": true, "version": true,
+	}
+	// managedPaths — поля, которые меняются только отдельными операциями.
+	managedPaths = map[string]string{
+		PathMasterID: "исполнителя меняют через ReassignOrderMaster, UnassignMaster или передачу заказа",
+	}
+)
+
+// OrderPatch — изменение заказа. Записываются только поля из Paths, в том числе
+// нулевые значения: так цена обнуляется, а описание очищается.
+type OrderPatch struct {
+	Paths       map[string]bool
+	Title       string
+	Description string
+	Address     string
+	Longitude   float64
+	Latitude    float64
+	Status      string
+	Price       float32
+	CategoryID  uuid.UUID
+}
+
+// Has сообщает, изменяется ли поле path.
+func (p *OrderPatch) Has(path string) bool {
+	return p.Paths[path]
+}
+
+// set отмечает поле path как изменяемое.
+func (p *OrderPatch) set(path string) {
+	if p.Paths == nil {
+		p.Paths = make(map[string]bool)
+	}
+	p.Paths[path] = true
+}
+
+// Edits сообщает, меняет ли патч что-то кроме статуса.
+func (p *OrderPatch) Edits() bool {
+	for path := range p.Paths {
+		if path != PathStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// apply заполняет builder полями патча.
+func (p *OrderPatch) apply(builder *ent.OrderUpdateOne) *ent.OrderUpdateOne {
+	if p.Has(PathTitle) {
+		builder = builder.SetTitle(p.Title)
+	}
+	if p.Has(PathDescription) {
+		builder = builder.SetDescription(p.Description)
+	}
+	if p.Has(PathAddress) {
+		builder = builder.SetAddress(p.Address)
+	}
+	if p.Has(PathLongitude) {
+		builder = builder.SetLongitude(p.Longitude)
+	}
+	if p.Has(PathLatitude) {
+		builder = builder.SetLatitude(p.Latitude)
+	}
+	if p.Has(PathStatus) {
+		builder = builder.SetStatus(order.Status(p.Status))
+	}
+	if p.Has(PathPrice) {
+		builder = builder.SetPrice(p.Price)
+	}
+	if p.Has(PathCategoryID) {
+		builder = builder.SetCategoryID(p.CategoryID)
+	}
+	return builder
+}
+
+// checkMask проверяет пути маски обновления: неизвестные, неизменяемые и
+// изменяемые отдельными операциями поля считаются ошибкой.
+func (v *validator) checkMask(mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		v.add("update_mask", "маска обновления пуста")
+		return
+	}
+	for _, path := range mask.GetPaths() {
+		switch {
+		case immutablePaths[path]:
+			v.add("update_mask", fmt.Sprintf("поле %s нельзя изменять", path))
+		case managedPaths[path] != "":
+			v.add(path, managedPaths[path])
+		case !updatablePaths[path]:
+			v.add("update_mask", fmt.Sprintf("неизвестное поле %s", path))
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package order
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPurge периодически окончательно удаляет заказы, мягко удалённые дольше retention.
+// Блокируется до отмены ctx.
+func RunPurge(ctx context.Context, svc Service, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := svc.Purge(ctx, retention)
+		if err != nil {
+			log.Printf("purge deleted orders: %v", err)
+		} else if n > 0 {
+			log.Printf("purged %d deleted orders", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,9 +3,16 @@ package order
 import (
 	"context"
 	"errors"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/mastertransfer"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/offer"
 	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/orderstatuschange"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/outboxevent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
 	"github.com/google/uuid"
 )
 
@@ -17,15 +24,63 @@ var (
 	ErrCreateOrderFailed  = errors.New("ошибка при создании заказа")
 	ErrUpdateOrderFailed  = errors.New("ошибка при обновлении заказа")
 	ErrInvalidId          = errors.New("неправильный формат UUID")
+	ErrVersionConflict    = errors.New("заказ был изменён другим пользователем, обновите данные и повторите")
+	ErrInvalidVersion     = errors.New("неправильный формат версии заказа")
+	ErrOrderNotDeleted    = errors.New("заказ не удалён")
+	ErrRestoreOrderFailed = errors.New("ошибка при восстановлении заказа")
+	ErrPurgeOrdersFailed  = errors.New("ошибка при окончательном удалении заказов")
+	ErrGetTimelineFailed  = errors.New("ошибка получения истории заказа")
+	ErrOrderNotActive     = errors.New("заказ не принимает предложения")
+	ErrOfferNotFound      = errors.New("предложение не найдено")
+	ErrOfferAlreadyExists = errors.New("исполнитель уже сделал предложение по этому заказу")
+	ErrOfferNotPending    = errors.New("предложение уже рассмотрено")
+	ErrCreateOfferFailed  = errors.New("ошибка при создании предложения")
+	ErrGetOffersFailed    = errors.New("ошибка получения предложений")
+	ErrUpdateOfferFailed  = errors.New("ошибка при обновлении предложения")
+	ErrOrderClosed        = errors.New("заказ уже выполнен или отменён")
+	ErrHardDeleteFailed   = errors.New("ошибка при окончательном удалении заказа")
+	ErrOrderNotInProgress = errors.New("заказ не в работе")
+
+	ErrTransferNotFound       = errors.New("передача заказа не найдена")
+	ErrTransferNotPending     = errors.New("передача заказа уже рассмотрена")
+	ErrTransferAlreadyPending = errors.New("по заказу уже ожидает ответа другая передача")
+	ErrTransferStale          = errors.New("исполнитель заказа сменился, передача недействительна")
+	ErrTransferFailed         = errors.New("ошибка при передаче заказа")
 )
 
 type Repoistory interface {
 	Get(ctx context.Context, id uuid.UUID) (*ent.Order, error)
-	GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id, master_id uuid.UUID) ([]*ent.Order, error)
+	GetIncludingDeleted(ctx context.Context, id uuid.UUID) (*ent.Order, error)
+	GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id, master_id uuid.UUID, page PageRequest) (*OrdersPage, error)
+	List(ctx context.Context, filter OrderFilter, page PageRequest) (*OrdersPage, error)
 	GetAllActive(ctx context.Context, categories_ids []uuid.UUID) ([]*ent.Order, error)
-	Create(ctx context.Context, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID) (*ent.Order, error)
-	Update(ctx context.Context, id uuid.UUID, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID) (*ent.Order, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetNearby(ctx context.Context, longitude, latitude, radius float64, categories_ids []uuid.UUID, limit int) ([]*NearbyOrder, error)
+	Search(ctx context.Context, query string, categories_ids []uuid.UUID, status string, visible predicate.Order, limit, offset int) ([]*ent.Order, error)
+	Create(ctx context.Context, title, description, address string, longitude, latitude float64, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID, idempotency_key, fingerprint string) (*ent.Order, error)
+	Update(ctx context.Context, id uuid.UUID, version int, patch *OrderPatch, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	Delete(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) (*ent.Order, error)
+	Purge(ctx context.Context, deleted_before time.Time) (int, error)
+	ForceStatus(ctx context.Context, id uuid.UUID, status order.Status, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	ReassignMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	HardDelete(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) error
+	UnassignMaster(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	GetTransfer(ctx context.Context, transfer_id uuid.UUID) (*ent.MasterTransfer, error)
+	RequestTransfer(ctx context.Context, order_id, to_master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.MasterTransfer, error)
+	AcceptTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.Order, error)
+	DeclineTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.MasterTransfer, error)
+	GetTimeline(ctx context.Context, order_id uuid.UUID) ([]*ent.OrderStatusChange, error)
+	CreateOffer(ctx context.Context, order_id, master_id uuid.UUID, price float32, comment string, estimated_at *time.Time) (*ent.Offer, error)
+	GetOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error)
+	GetOffers(ctx context.Context, order_id uuid.UUID) ([]*ent.Offer, error)
+	AcceptOffer(ctx context.Context, offer_id, actor_id uuid.UUID) (*ent.Order, error)
+	RejectOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error)
+	ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]*ent.OutboxEvent, error)
+	MarkEventPublished(ctx context.Context, id uuid.UUID) error
+	MarkEventFailed(ctx context.Context, id uuid.UUID, last_error string, next_attempt_at time.Time, dead bool) error
+	GetEvent(ctx context.Context, id uuid.UUID) (*ent.OutboxEvent, error)
+	NotifyEvent(ctx context.Context, channel string, id uuid.UUID) error
+	CountByStatusCategory(ctx context.Context) ([]OrderCount, error)
 }
 
 type repo struct {
@@ -36,8 +91,54 @@ func NewRepo(client *ent.Client) Repoistory {
 	return &repo{client: client}
 }
 
+// withTx выполняет fn в транзакции: откатывает её при ошибке и фиксирует при успехе.
+func (r *repo) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// recordStatusChange записывает изменение статуса заказа в историю в рамках транзакции tx.
+func recordStatusChange(ctx context.Context, tx *ent.Tx, order_id uuid.UUID, from *order.Status, to order.Status, actor_id uuid.UUID, reason string) error {
+	builder := tx.OrderStatusChange.Create().
+		SetOrderID(order_id).
+		SetToStatus(orderstatuschange.ToStatus(to)).
+		SetReason(reason)
+	if from != nil {
+		builder = builder.SetFromStatus(orderstatuschange.FromStatus(*from))
+	}
+	if actor_id != uuid.Nil {
+		builder = builder.SetActorID(actor_id)
+	}
+	return builder.Exec(ctx)
+}
+
+// exists проверяет, что заказ существует и не удалён.
+func (r *repo) exists(ctx context.Context, id uuid.UUID) (bool, error) {
+	return r.client.Order.Query().Where(order.IDEQ(id), order.DeletedAtIsNil()).Exist(ctx)
+}
+
+// getAlive возвращает неудалённый заказ в рамках транзакции tx.
+func getAlive(ctx context.Context, tx *ent.Tx, id uuid.UUID) (*ent.Order, error) {
+	return tx.Order.Query().Where(order.IDEQ(id), order.DeletedAtIsNil()).Only(ctx)
+}
+
 func (r *repo) Get(ctx context.Context, id uuid.UUID) (*ent.Order, error) {
-	order, err := r.client.Order.Get(ctx, id)
+	order, err := r.client.Order.Query().Where(order.IDEQ(id), order.DeletedAtIsNil()).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrOrderNotFound
@@ -48,44 +149,77 @@ func (r *repo) Get(ctx context.Context, id uuid.UUID) (*ent.Order, error) {
 	return order, nil
 }
 
+// GetIncludingDeleted возвращает заказ, в том числе мягко удалённый.
+func (r *repo) GetIncludingDeleted(ctx context.Context, id uuid.UUID) (*ent.Order, error) {
+	o, err := r.client.Order.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrGetOrderFailed
+	}
+
+	return o, nil
+}
+
 func (r *repo) GetAll(
 	ctx context.Context,
 	categories_ids []uuid.UUID,
 	status string,
 	client_id,
 	master_id uuid.UUID,
-) ([]*ent.Order, error) {
-	q := r.client.Order.Query()
-
-	if len(categories_ids) > 0 {
-		q = q.Where(order.CategoryIDIn(categories_ids...))
+	page PageRequest,
+) (*OrdersPage, error) {
+	filter := OrderFilter{
+		ClientID:    client_id,
+		MasterID:    master_id,
+		CategoryIDs: categories_ids,
 	}
-
 	if status != "" {
-		q = q.Where(order.StatusEQ(order.Status(status)))
+		filter.Statuses = []order.Status{order.Status(status)}
 	}
+	return r.List(ctx, filter, page)
+}
 
-	if client_id != uuid.Nil {
-		q = q.Where(order.ClientIDEQ(client_id))
+// List возвращает страницу заказов, подходящих под filter.
+func (r *repo) List(ctx context.Context, filter OrderFilter, page PageRequest) (*OrdersPage, error) {
+	page, err := page.normalize()
+	if err != nil {
+		return nil, err
 	}
 
-	if master_id != uuid.Nil {
-		q = q.Where(order.MasterIDEQ(master_id))
-	}
+	q := r.client.Order.Query().Where(filter.predicates()...)
 
-	orders, err := q.All(ctx)
+	total, err := q.Clone().Count(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, ErrGetAllOrderFailed
+		return nil, ErrGetAllOrderFailed
+	}
+
+	if page.Cursor != "" {
+		c, err := decodeCursor(page.Cursor, page.Sort)
+		if err != nil {
+			return nil, err
 		}
-		return nil, err
+		q = q.Where(page.after(c))
 	}
 
-	return orders, nil
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница.
+	orders, err := q.Order(page.orderTerms()...).Limit(page.Size + 1).All(ctx)
+	if err != nil {
+		return nil, ErrGetAllOrderFailed
+	}
+
+	res := &OrdersPage{Orders: orders, Total: total}
+	if len(orders) > page.Size {
+		res.Orders = orders[:page.Size]
+		res.NextCursor = encodeCursor(page.Sort, res.Orders[page.Size-1])
+	}
+
+	return res, nil
 }
 
 func (r *repo) GetAllActive(ctx context.Context, categories_ids []uuid.UUID) ([]*ent.Order, error) {
-	q := r.client.Order.Query()
+	q := r.client.Order.Query().Where(order.DeletedAtIsNil())
 
 	if len(categories_ids) > 0 {
 		q = q.Where(order.CategoryIDIn(categories_ids...))
@@ -103,81 +237,918 @@ func (r *repo) GetAllActive(ctx context.Context, categories_ids []uuid.UUID) ([]
 	return orders, nil
 }
 
-func (r *repo) Create(ctx context.Context, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID) (*ent.Order, error) {
-	order, err := r.client.Order.Create().
-		SetTitle(title).
-		SetDescription(description).
-		SetAddress(address).
-		SetLongitude(longitude).
-		SetLatitude(latitude).
-		SetStatus(order.Status(status)).
-		SetPrice(price).SetCategoryID(category_id).
-		SetClientID(client_id).
-		SetStatus(order.StatusActive).
-		Save(ctx)
+func (r *repo) GetNearby(ctx context.Context, longitude, latitude, radius float64, categories_ids []uuid.UUID, limit int) ([]*NearbyOrder, error) {
+	q := r.client.Order.Query().
+		Where(
+			order.DeletedAtIsNil(),
+			order.StatusEQ(order.StatusActive),
+			withinRadius(longitude, latitude, radius),
+		)
+
+	if len(categories_ids) > 0 {
+		q = q.Where(order.CategoryIDIn(categories_ids...))
+	}
+
+	orders, err := q.Order(byDistance(longitude, latitude)).Limit(limit).All(ctx)
+	if err != nil {
+		return nil, ErrGetAllOrderFailed
+	}
+
+	out := make([]*NearbyOrder, len(orders))
+	for i, o := range orders {
+		out[i] = &NearbyOrder{
+			Order:    o,
+			Distance: haversine(longitude, latitude, o.Longitude, o.Latitude),
+		}
+	}
+	return out, nil
+}
+
+// Search ищет заказы по тексту. visible ограничивает выдачу заказами, которые
+// может видеть пользователь; nil — без ограничений.
+func (r *repo) Search(ctx context.Context, query string, categories_ids []uuid.UUID, status string, visible predicate.Order, limit, offset int) ([]*ent.Order, error) {
+	q := r.client.Order.Query().Where(order.DeletedAtIsNil(), matchesSearch(query))
+
+	if len(categories_ids) > 0 {
+		q = q.Where(order.CategoryIDIn(categories_ids...))
+	}
+
+	if status != "" {
+		q = q.Where(order.StatusEQ(order.Status(status)))
+	}
+
+	if visible != nil {
+		q = q.Where(visible)
+	}
+
+	orders, err := q.Order(bySearchRank(query)).Limit(limit).Offset(offset).All(ctx)
+	if err != nil {
+		return nil, ErrSearchFailed
+	}
+	return orders, nil
+}
+
+// Create создаёт заказ. Если передан idempotency_key, повторный запрос клиента
+// с тем же ключом и отпечатком fingerprint возвращает ранее созданный заказ.
+func (r *repo) Create(ctx context.Context, title, description, address string, longitude, latitude float64, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID, idempotency_key, fingerprint string) (*ent.Order, error) {
+	if idempotency_key != "" {
+		if o, err := r.replayCreate(ctx, client_id, idempotency_key, fingerprint); o != nil || err != nil {
+			return o, err
+		}
+	}
+
+	var created *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		created, err = tx.Order.Create().
+			SetTitle(title).
+			SetDescription(description).
+			SetAddress(address).
+			SetLongitude(longitude).
+			SetLatitude(latitude).
+			SetStatus(order.Status(status)).
+			SetPrice(price).SetCategoryID(category_id).
+			SetClientID(client_id).
+			SetStatus(order.StatusActive).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if err := recordStatusChange(ctx, tx, created.ID, nil, created.Status, client_id, ""); err != nil {
+			return err
+		}
+		if idempotency_key != "" {
+			err := tx.IdempotencyKey.Create().
+				SetKey(idempotency_key).
+				SetClientID(client_id).
+				SetFingerprint(fingerprint).
+				SetOrderID(created.ID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		return recordEvent(ctx, tx, EventOrderCreated, created, nil, client_id)
+	})
 	if err != nil {
 		if ent.IsConstraintError(err) {
+			// Параллельный запрос с тем же ключом успел создать заказ первым
+			if idempotency_key != "" {
+				if o, rerr := r.replayCreate(ctx, client_id, idempotency_key, fingerprint); o != nil || rerr != nil {
+					return o, rerr
+				}
+			}
 			return nil, ErrOrderAlreadyExists
 		}
 		return nil, ErrCreateOrderFailed
 	}
 
-	return order, nil
+	return created, nil
 }
 
-func (r *repo) Update(ctx context.Context, id uuid.UUID, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID) (*ent.Order, error) {
-	builder := r.client.Order.UpdateOneID(id)
+// Update записывает в заказ поля патча. Если version не 0, заказ должен иметь
+// именно эту версию, иначе возвращается ErrVersionConflict.
+func (r *repo) Update(ctx context.Context, id uuid.UUID, version int, patch *OrderPatch, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	var updated *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := getAlive(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if version != 0 && current.Version != version {
+			return ErrVersionConflict
+		}
 
-	if title != "" {
-		builder = builder.SetTitle(title)
+		// Условие на версию защищает от изменений, сделанных после чтения current
+		updated, err = patch.apply(tx.Order.UpdateOneID(id).Where(order.VersionEQ(current.Version))).Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+
+		if updated.Status != current.Status {
+			if err := recordStatusChange(ctx, tx, id, &current.Status, updated.Status, actor_id, reason); err != nil {
+				return err
+			}
+			if err := cancelPendingTransfers(ctx, tx, id, actor_id); err != nil {
+				return err
+			}
+		}
+		return recordUpdateEvents(ctx, tx, current, updated, actor_id)
+	})
+	if err != nil {
+		if errors.Is(err, ErrVersionConflict) {
+			return nil, err
+		}
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrUpdateOrderFailed
 	}
-	if description != "" {
-		builder = builder.SetDescription(description)
+
+	return updated, nil
+}
+
+// Delete мягко удаляет заказ, проставляя deleted_at. Если version не 0, заказ должен
+// иметь именно эту версию, иначе возвращается ErrVersionConflict.
+func (r *repo) Delete(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) error {
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := getAlive(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if version != 0 && current.Version != version {
+			return ErrVersionConflict
+		}
+
+		deleted, err := tx.Order.UpdateOneID(id).
+			Where(order.VersionEQ(current.Version)).
+			SetDeletedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+		return recordEvent(ctx, tx, EventOrderDeleted, deleted, nil, actor_id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrVersionConflict):
+			return err
+		case ent.IsNotFound(err):
+			return ErrOrderNotFound
+		}
+		return ErrGetAllOrderFailed
 	}
-	if address != "" {
-		builder = builder.SetAddress(address)
+
+	return nil
+}
+
+func (r *repo) Restore(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) (*ent.Order, error) {
+	var restored *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := tx.Order.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if current.DeletedAt == nil {
+			return ErrOrderNotDeleted
+		}
+		if version != 0 && current.Version != version {
+			return ErrVersionConflict
+		}
+
+		restored, err = tx.Order.UpdateOneID(id).
+			Where(order.VersionEQ(current.Version), order.DeletedAtNotNil()).
+			ClearDeletedAt().
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+		return recordEvent(ctx, tx, EventOrderRestored, restored, nil, actor_id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrVersionConflict), errors.Is(err, ErrOrderNotDeleted):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrRestoreOrderFailed
 	}
-	if longitude != "" {
-		builder = builder.SetLongitude(longitude)
+
+	return restored, nil
+}
+
+// Purge окончательно удаляет заказы, мягко удалённые раньше deleted_before.
+// О каждом удалённом заказе в outbox записывается событие order_deleted.
+func (r *repo) Purge(ctx context.Context, deleted_before time.Time) (int, error) {
+	var n int
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		expired, err := tx.Order.Query().
+			Where(order.DeletedAtLT(deleted_before)).
+			ForUpdate().
+			All(ctx)
+		if err != nil || len(expired) == 0 {
+			return err
+		}
+		ids := make([]uuid.UUID, len(expired))
+		for i, o := range expired {
+			ids[i] = o.ID
+			ev := newOrderEvent(o, nil, uuid.Nil)
+			ev.Reason = "истёк срок хранения удалённого заказа"
+			if err := insertEvent(ctx, tx, EventOrderDeleted, ev); err != nil {
+				return err
+			}
+		}
+		n, err = tx.Order.Delete().Where(order.IDIn(ids...)).Exec(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, ErrPurgeOrdersFailed
 	}
-	if latitude != "" {
-		builder = builder.SetLatitude(latitude)
+	return n, nil
+}
+
+// ForceStatus переводит заказ в status в обход таблицы переходов. При возврате
+// в active исполнитель снимается с заказа; в in_progress и done можно перевести
+// только заказ с исполнителем.
+func (r *repo) ForceStatus(ctx context.Context, id uuid.UUID, status order.Status, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	var updated *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := getAlive(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if current.Status == status {
+			updated = current
+			return nil
+		}
+		if (status == order.StatusInProgress || status == order.StatusDone) && current.MasterID == uuid.Nil {
+			return invalidField("status", "у заказа нет исполнителя")
+		}
+
+		builder := tx.Order.UpdateOneID(id).
+			Where(order.VersionEQ(current.Version)).
+			SetStatus(status)
+		if status == order.StatusActive {
+			builder = builder.ClearMasterID()
+		}
+		updated, err = builder.Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+
+		if err := recordStatusChange(ctx, tx, id, &current.Status, status, actor_id, reason); err != nil {
+			return err
+		}
+		if err := cancelPendingTransfers(ctx, tx, id, actor_id); err != nil {
+			return err
+		}
+		ev := newOrderEvent(updated, &current.Status, actor_id)
+		ev.Reason = reason
+		if current.MasterID != updated.MasterID {
+			ev.PreviousMasterID = &current.MasterID
+		}
+		return insertEvent(ctx, tx, EventOrderStatusChanged, ev)
+	})
+	if err != nil {
+		var fieldErr *FieldError
+		switch {
+		case errors.Is(err, ErrVersionConflict), errors.As(err, &fieldErr):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrUpdateOrderFailed
 	}
-	if status != "" {
-		builder = builder.SetStatus(order.Status(status))
+
+	return updated, nil
+}
+
+// ReassignMaster назначает на заказ исполнителя master_id. Заказ без исполнителя
+// при этом переходит в in_progress, а ожидающие предложения по нему отклоняются.
+func (r *repo) ReassignMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	var updated *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := getAlive(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if current.Status == order.StatusDone || current.Status == order.StatusCancel {
+			return ErrOrderClosed
+		}
+		if current.MasterID == master_id {
+			updated = current
+			return nil
+		}
+
+		updated, err = tx.Order.UpdateOneID(id).
+			Where(order.VersionEQ(current.Version)).
+			SetMasterID(master_id).
+			SetStatus(order.StatusInProgress).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+
+		if err := cancelPendingTransfers(ctx, tx, id, actor_id); err != nil {
+			return err
+		}
+		ev := newOrderEvent(updated, &current.Status, actor_id)
+		ev.Reason = reason
+		if current.MasterID != uuid.Nil {
+			ev.PreviousMasterID = &current.MasterID
+		}
+		if err := insertEvent(ctx, tx, EventOrderAssigned, ev); err != nil {
+			return err
+		}
+		if current.Status == updated.Status {
+			return nil
+		}
+
+		err = tx.Offer.Update().
+			Where(offer.OrderIDEQ(id), offer.StatusEQ(offer.StatusPending)).
+			SetStatus(offer.StatusRejected).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := recordStatusChange(ctx, tx, id, &current.Status, updated.Status, actor_id, reason); err != nil {
+			return err
+		}
+		return insertEvent(ctx, tx, EventOrderStatusChanged, ev)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrVersionConflict), errors.Is(err, ErrOrderClosed):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrUpdateOrderFailed
 	}
-	if price != 0 {
-		builder = builder.SetPrice(price)
+
+	return updated, nil
+}
+
+// HardDelete окончательно удаляет заказ, в том числе мягко удалённый, вместе с
+// историей статусов и предложениями. Событие об удалении остаётся в outbox.
+func (r *repo) HardDelete(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) error {
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		o, err := tx.Order.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		ev := newOrderEvent(o, nil, actor_id)
+		ev.Reason = reason
+		if err := insertEvent(ctx, tx, EventOrderDeleted, ev); err != nil {
+			return err
+		}
+		return tx.Order.DeleteOneID(id).Exec(ctx)
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrOrderNotFound
+		}
+		return ErrHardDeleteFailed
 	}
 
-	if category_id != uuid.Nil {
-		builder = builder.SetCategoryID(category_id)
+	return nil
+}
+
+// cancelPendingTransfers отменяет ожидающие передачи заказа: после смены
+// исполнителя или статуса они теряют смысл.
+func cancelPendingTransfers(ctx context.Context, tx *ent.Tx, order_id uuid.UUID, actor_id uuid.UUID) error {
+	builder := tx.MasterTransfer.Update().
+		Where(mastertransfer.OrderIDEQ(order_id), mastertransfer.StatusEQ(mastertransfer.StatusPending)).
+		SetStatus(mastertransfer.StatusCancelled)
+	if actor_id != uuid.Nil {
+		builder = builder.SetDecidedBy(actor_id)
 	}
+	return builder.Exec(ctx)
+}
 
-	if master_id != uuid.Nil {
-		builder = builder.SetMasterID(master_id)
+// UnassignMaster снимает исполнителя с заказа в работе и возвращает заказ в active.
+func (r *repo) UnassignMaster(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	var updated *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		current, err := getAlive(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if current.Status != order.StatusInProgress {
+			return ErrOrderNotInProgress
+		}
+
+		updated, err = tx.Order.UpdateOneID(id).
+			Where(order.VersionEQ(current.Version)).
+			SetStatus(order.StatusActive).
+			ClearMasterID().
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+
+		if err := recordStatusChange(ctx, tx, id, &current.Status, updated.Status, actor_id, reason); err != nil {
+			return err
+		}
+		if err := cancelPendingTransfers(ctx, tx, id, actor_id); err != nil {
+			return err
+		}
+		ev := newOrderEvent(updated, &current.Status, actor_id)
+		ev.Reason = reason
+		ev.PreviousMasterID = &current.MasterID
+		if err := insertEvent(ctx, tx, EventOrderUnassigned, ev); err != nil {
+			return err
+		}
+		return insertEvent(ctx, tx, EventOrderStatusChanged, ev)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrVersionConflict), errors.Is(err, ErrOrderNotInProgress):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrUpdateOrderFailed
 	}
 
-	updated, err := builder.Save(ctx)
+	return updated, nil
+}
+
+func (r *repo) GetTransfer(ctx context.Context, transfer_id uuid.UUID) (*ent.MasterTransfer, error) {
+	t, err := r.client.MasterTransfer.Get(ctx, transfer_id)
 	if err != nil {
 		if ent.IsNotFound(err) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, ErrTransferFailed
+	}
+
+	return t, nil
+}
+
+// RequestTransfer предлагает передать заказ в работе от текущего исполнителя
+// исполнителю to_master_id. Ожидающие передачи от прежних исполнителей отменяются.
+func (r *repo) RequestTransfer(ctx context.Context, order_id, to_master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.MasterTransfer, error) {
+	var created *ent.MasterTransfer
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		o, err := getAlive(ctx, tx, order_id)
+		if err != nil {
+			return err
+		}
+		if o.Status != order.StatusInProgress {
+			return ErrOrderNotInProgress
+		}
+
+		err = tx.MasterTransfer.Update().
+			Where(
+				mastertransfer.OrderIDEQ(order_id),
+				mastertransfer.StatusEQ(mastertransfer.StatusPending),
+				mastertransfer.FromMasterIDNEQ(o.MasterID),
+			).
+			SetStatus(mastertransfer.StatusCancelled).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		created, err = tx.MasterTransfer.Create().
+			SetOrderID(order_id).
+			SetFromMasterID(o.MasterID).
+			SetToMasterID(to_master_id).
+			SetReason(reason).
+			SetRequestedBy(actor_id).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		ev := newOrderEvent(o, nil, actor_id)
+		ev.Reason = reason
+		ev.TransferMasterID = &to_master_id
+		return insertEvent(ctx, tx, EventTransferRequested, ev)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOrderNotInProgress):
+			return nil, err
+		case ent.IsNotFound(err):
 			return nil, ErrOrderNotFound
+		case ent.IsConstraintError(err):
+			return nil, ErrTransferAlreadyPending
 		}
-		return nil, ErrUpdateOrderFailed
+		return nil, ErrTransferFailed
+	}
+
+	return created, nil
+}
+
+// AcceptTransfer назначает на заказ исполнителя, которому он передан. Передача
+// принимается, только пока заказ в работе у того же исполнителя, что её запросил.
+func (r *repo) AcceptTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.Order, error) {
+	var updated *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		t, err := tx.MasterTransfer.Query().
+			Where(mastertransfer.IDEQ(transfer_id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrTransferNotFound
+			}
+			return err
+		}
+		if t.Status != mastertransfer.StatusPending {
+			return ErrTransferNotPending
+		}
+		current, err := getAlive(ctx, tx, t.OrderID)
+		if err != nil {
+			return err
+		}
+		if current.Status != order.StatusInProgress || current.MasterID != t.FromMasterID {
+			return ErrTransferStale
+		}
+
+		updated, err = tx.Order.UpdateOneID(current.ID).
+			Where(order.VersionEQ(current.Version)).
+			SetMasterID(t.ToMasterID).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrVersionConflict
+			}
+			return err
+		}
+		err = tx.MasterTransfer.UpdateOneID(t.ID).
+			SetStatus(mastertransfer.StatusAccepted).
+			SetDecidedBy(actor_id).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		ev := newOrderEvent(updated, nil, actor_id)
+		ev.Reason = t.Reason
+		ev.PreviousMasterID = &t.FromMasterID
+		return insertEvent(ctx, tx, EventOrderAssigned, ev)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrTransferNotFound), errors.Is(err, ErrTransferNotPending),
+			errors.Is(err, ErrTransferStale), errors.Is(err, ErrVersionConflict):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		}
+		return nil, ErrTransferFailed
 	}
 
 	return updated, nil
 }
 
-func (r *repo) Delete(ctx context.Context, id uuid.UUID) error {
-	err := r.client.Order.DeleteOneID(id).Exec(ctx)
+// DeclineTransfer отклоняет ожидающую передачу заказа.
+func (r *repo) DeclineTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.MasterTransfer, error) {
+	var declined *ent.MasterTransfer
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		var err error
+		declined, err = tx.MasterTransfer.UpdateOneID(transfer_id).
+			Where(mastertransfer.StatusEQ(mastertransfer.StatusPending)).
+			SetStatus(mastertransfer.StatusDeclined).
+			SetDecidedBy(actor_id).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				exists, qerr := tx.MasterTransfer.Query().Where(mastertransfer.IDEQ(transfer_id)).Exist(ctx)
+				if qerr == nil && exists {
+					return ErrTransferNotPending
+				}
+				return ErrTransferNotFound
+			}
+			return err
+		}
+
+		o, err := tx.Order.Get(ctx, declined.OrderID)
+		if err != nil {
+			return err
+		}
+		ev := newOrderEvent(o, nil, actor_id)
+		ev.Reason = declined.Reason
+		ev.TransferMasterID = &declined.ToMasterID
+		return insertEvent(ctx, tx, EventTransferDeclined, ev)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrTransferNotFound), errors.Is(err, ErrTransferNotPending):
+			return nil, err
+		}
+		return nil, ErrTransferFailed
+	}
+
+	return declined, nil
+}
+
+func (r *repo) GetTimeline(ctx context.Context, order_id uuid.UUID) ([]*ent.OrderStatusChange, error) {
+	exists, err := r.exists(ctx, order_id)
+	if err != nil {
+		return nil, ErrGetTimelineFailed
+	}
+	if !exists {
+		return nil, ErrOrderNotFound
+	}
+
+	changes, err := r.client.OrderStatusChange.Query().
+		Where(orderstatuschange.OrderIDEQ(order_id)).
+		Order(ent.Asc(orderstatuschange.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, ErrGetTimelineFailed
+	}
+
+	return changes, nil
+}
+
+func (r *repo) CreateOffer(ctx context.Context, order_id, master_id uuid.UUID, price float32, comment string, estimated_at *time.Time) (*ent.Offer, error) {
+	var created *ent.Offer
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		o, err := getAlive(ctx, tx, order_id)
+		if err != nil {
+			return err
+		}
+		if o.Status != order.StatusActive {
+			return ErrOrderNotActive
+		}
+
+		created, err = tx.Offer.Create().
+			SetOrderID(order_id).
+			SetMasterID(master_id).
+			SetPrice(price).
+			SetComment(comment).
+			SetNillableEstimatedAt(estimated_at).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOrderNotActive):
+			return nil, err
+		case ent.IsNotFound(err):
+			return nil, ErrOrderNotFound
+		case ent.IsConstraintError(err):
+			return nil, ErrOfferAlreadyExists
+		}
+		return nil, ErrCreateOfferFailed
+	}
+
+	return created, nil
+}
+
+func (r *repo) GetOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error) {
+	of, err := r.client.Offer.Get(ctx, offer_id)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return ErrOrderNotFound
-		} else {
-			return ErrGetAllOrderFailed
+			return nil, ErrOfferNotFound
 		}
+		return nil, ErrGetOffersFailed
 	}
 
-	return nil
+	return of, nil
+}
+
+func (r *repo) GetOffers(ctx context.Context, order_id uuid.UUID) ([]*ent.Offer, error) {
+	exists, err := r.exists(ctx, order_id)
+	if err != nil {
+		return nil, ErrGetOffersFailed
+	}
+	if !exists {
+		return nil, ErrOrderNotFound
+	}
+
+	offers, err := r.client.Offer.Query().
+		Where(offer.OrderIDEQ(order_id)).
+		Order(ent.Asc(offer.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, ErrGetOffersFailed
+	}
+
+	return offers, nil
+}
+
+// AcceptOffer назначает исполнителя предложения на заказ, переносит цену и переводит заказ в in_progress.
+// Заказ обновляется условно (только из статуса active), поэтому из двух одновременных
+// принятий предложений по одному заказу успешно только одно.
+func (r *repo) AcceptOffer(ctx context.Context, offer_id, actor_id uuid.UUID) (*ent.Order, error) {
+	var accepted *ent.Order
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		of, err := tx.Offer.Get(ctx, offer_id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrOfferNotFound
+			}
+			return err
+		}
+		if of.Status != offer.StatusPending {
+			return ErrOfferNotPending
+		}
+
+		n, err := tx.Order.Update().
+			Where(order.IDEQ(of.OrderID), order.DeletedAtIsNil(), order.StatusEQ(order.StatusActive)).
+			SetMasterID(of.MasterID).
+			SetPrice(of.Price).
+			SetStatus(order.StatusInProgress).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrOrderNotActive
+		}
+
+		err = tx.Offer.UpdateOneID(of.ID).
+			Where(offer.StatusEQ(offer.StatusPending)).
+			SetStatus(offer.StatusAccepted).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return ErrOfferNotPending
+			}
+			return err
+		}
+
+		err = tx.Offer.Update().
+			Where(
+				offer.OrderIDEQ(of.OrderID),
+				offer.StatusEQ(offer.StatusPending),
+			).
+			SetStatus(offer.StatusRejected).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		from := order.StatusActive
+		if err := recordStatusChange(ctx, tx, of.OrderID, &from, order.StatusInProgress, actor_id, "принято предложение исполнителя"); err != nil {
+			return err
+		}
+
+		accepted, err = tx.Order.Get(ctx, of.OrderID)
+		if err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, tx, EventOrderAssigned, accepted, &from, actor_id); err != nil {
+			return err
+		}
+		return recordEvent(ctx, tx, EventOrderStatusChanged, accepted, &from, actor_id)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrOfferNotFound), errors.Is(err, ErrOfferNotPending), errors.Is(err, ErrOrderNotActive):
+			return nil, err
+		}
+		return nil, ErrUpdateOfferFailed
+	}
+
+	return accepted, nil
+}
+
+func (r *repo) RejectOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error) {
+	rejected, err := r.client.Offer.UpdateOneID(offer_id).
+		Where(offer.StatusEQ(offer.StatusPending)).
+		SetStatus(offer.StatusRejected).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			exists, qerr := r.client.Offer.Query().Where(offer.IDEQ(offer_id)).Exist(ctx)
+			if qerr == nil && exists {
+				return nil, ErrOfferNotPending
+			}
+			return nil, ErrOfferNotFound
+		}
+		return nil, ErrUpdateOfferFailed
+	}
+
+	return rejected, nil
+}
+
+// ClaimEvents забирает до limit готовых к отправке событий и резервирует их на lease,
+// чтобы другие экземпляры сервиса не отправляли их одновременно.
+func (r *repo) ClaimEvents(ctx context.Context, limit int, lease time.Duration) ([]*ent.OutboxEvent, error) {
+	var events []*ent.OutboxEvent
+	err := r.withTx(ctx, func(tx *ent.Tx) error {
+		now := time.Now()
+		var err error
+		events, err = tx.OutboxEvent.Query().
+			Where(
+				outboxevent.StatusEQ(outboxevent.StatusPending),
+				outboxevent.NextAttemptAtLTE(now),
+			).
+			Order(ent.Asc(outboxevent.FieldCreatedAt)).
+			Limit(limit).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(events))
+		for i, ev := range events {
+			ids[i] = ev.ID
+		}
+		return tx.OutboxEvent.Update().
+			Where(outboxevent.IDIn(ids...)).
+			SetNextAttemptAt(now.Add(lease)).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, ErrGetEventsFailed
+	}
+
+	return events, nil
+}
+
+func (r *repo) MarkEventPublished(ctx context.Context, id uuid.UUID) error {
+	return r.client.OutboxEvent.UpdateOneID(id).
+		SetStatus(outboxevent.StatusPublished).
+		SetPublishedAt(time.Now()).
+		SetLastError("").
+		Exec(ctx)
+}
+
+func (r *repo) MarkEventFailed(ctx context.Context, id uuid.UUID, last_error string, next_attempt_at time.Time, dead bool) error {
+	builder := r.client.OutboxEvent.UpdateOneID(id).
+		AddAttempts(1).
+		SetLastError(last_error).
+		SetNextAttemptAt(next_attempt_at)
+	if dead {
+		builder = builder.SetStatus(outboxevent.StatusDead)
+	}
+	return builder.Exec(ctx)
+}
+
+func (r *repo) GetEvent(ctx context.Context, id uuid.UUID) (*ent.OutboxEvent, error) {
+	return r.client.OutboxEvent.Get(ctx, id)
+}
+
+// NotifyEvent отправляет ID события в канал PostgreSQL NOTIFY.
+func (r *repo) NotifyEvent(ctx context.Context, channel string, id uuid.UUID) error {
+	_, err := r.client.ExecContext(ctx, "SELECT pg_notify($1, $2)", channel, id.String())
+	return err
+}
+
+// OrderCount — число неудалённых заказов с данными статусом и категорией.
+type OrderCount struct {
+	Status     string    `json:"status"`
+	CategoryID uuid.UUID `json:"category_id"`
+	Count      int       `json:"count"`
+}
+
+func (r *repo) CountByStatusCategory(ctx context.Context) ([]OrderCount, error) {
+	var counts []OrderCount
+	err := r.client.Order.Query().
+		Where(order.DeletedAtIsNil()).
+		GroupBy(order.FieldStatus, order.FieldCategoryID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, ErrGetAllOrderFailed
+	}
+	return counts, nil
 }
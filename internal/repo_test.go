@@ -0,0 +1,36 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+)
+
+func TestForceStatusRequiresMaster(t *testing.T) {
+	for _, to := range []order.Status{order.StatusInProgress, order.StatusDone} {
+		t.Run(to.String(), func(t *testing.T) {
+			r, mock := newMockRepo(t)
+			id := uuid.New()
+			now := time.Now()
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM "orders" WHERE "orders"."id" = \$1 AND "orders"."deleted_at" IS NULL`).
+				WithArgs(id).
+				WillReturnRows(orderRows().AddRow(id, "Шкаф", "", 3000, "Москва, ул. Ленина, 1", 37.6, 55.7,
+					uuid.New(), uuid.New(), nil, "active", 1, now, now, nil))
+			mock.ExpectRollback()
+
+			_, err := r.ForceStatus(context.Background(), id, to, uuid.New(), "проверка")
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != "status" {
+				t.Errorf("ForceStatus() error = %v, want invalid status field", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package order
+
+import (
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+)
+
+const (
+	// searchConfig — конфигурация полнотекстового поиска PostgreSQL (русская морфология).
+	searchConfig = "russian"
+
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+	MaxSearchQueryLen  = 256
+)
+
+var (
+	ErrEmptySearchQuery = errors.New("пустой поисковый запрос")
+	ErrSearchFailed     = errors.New("ошибка поиска заказов")
+)
+
+// searchDocumentSQL пишет в b выражение tsvector по названию, описанию и адресу заказа.
+// Должно совпадать с выражением индекса orders_search_idx в
+// db/migrations/20261018000000_initial.up.sql.
+func searchDocumentSQL(b *sql.Builder, s *sql.Selector) {
+	b.WriteString("to_tsvector('" + searchConfig + "', ").
+		WriteString(s.C(order.FieldTitle)).WriteString(" || ' ' || ").
+		WriteString(s.C(order.FieldDescription)).WriteString(" || ' ' || ").
+		WriteString(s.C(order.FieldAddress)).WriteString(")")
+}
+
+func searchQuerySQL(b *sql.Builder, query string) {
+	b.WriteString("websearch_to_tsquery('" + searchConfig + "', ").Arg(query).WriteString(")")
+}
+
+// matchesSearch отбирает заказы, подходящие под поисковый запрос.
+func matchesSearch(query string) predicate.Order {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			searchDocumentSQL(b, s)
+			b.WriteString(" @@ ")
+			searchQuerySQL(b, query)
+		}))
+	}
+}
+
+// bySearchRank сортирует заказы по релевантности запросу, при равенстве — от новых к старым.
+func bySearchRank(query string) order.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExprFunc(func(b *sql.Builder) {
+			b.WriteString("ts_rank(")
+			searchDocumentSQL(b, s)
+			b.WriteString(", ")
+			searchQuerySQL(b, query)
+			b.WriteString(") DESC")
+		})
+		order.ByCreatedAt(sql.OrderDesc())(s)
+	}
+}
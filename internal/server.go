@@ -2,80 +2,250 @@ package order
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	commonpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/common/v1"
 	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
-	userpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/user/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	orderextpbv1 "github.com/Ostap00034/course-work-backend-order-service/gen/go/orderext/v1"
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type Server struct {
 	orderpbv1.UnimplementedOrderServiceServer
-	svc     Service
-	userSvc userpbv1.UserServiceClient
+	orderextpbv1.UnimplementedOrderExtServiceServer
+	svc   Service
+	users *UserResolver
+	watch *Broadcaster
 }
 
-func NewServer(svc Service, userSvc userpbv1.UserServiceClient) *Server {
-	return &Server{svc: svc, userSvc: userSvc}
+func NewServer(svc Service, users *UserResolver, watch *Broadcaster) *Server {
+	return &Server{svc: svc, users: users, watch: watch}
+}
+
+// orderData переводит заказ в модель API без данных клиента и исполнителя.
+func orderData(o *ent.Order) *commonpbv1.OrderData {
+	return &commonpbv1.OrderData{
+		Id:          o.ID.String(),
+		Title:       o.Title,
+		Description: o.Description,
+		Address:     o.Address,
+		Longitude:   FormatCoordinate(o.Longitude),
+		Latitude:    FormatCoordinate(o.Latitude),
+		Status:      o.Status.String(),
+		Price:       o.Price,
+		CategoryId:  o.CategoryID.String(),
+		CreatedAt:   o.CreatedAt.String(),
+		UpdatedAt:   o.UpdatedAt.String(),
+	}
+}
+
+// offerData переводит предложение исполнителя в модель API.
+func offerData(of *ent.Offer) *orderextpbv1.OfferData {
+	var estimated_at string
+	if of.EstimatedAt != nil {
+		estimated_at = of.EstimatedAt.Format(time.RFC3339)
+	}
+	return &orderextpbv1.OfferData{
+		Id:          of.ID.String(),
+		OrderId:     of.OrderID.String(),
+		MasterId:    of.MasterID.String(),
+		Price:       of.Price,
+		Comment:     of.Comment,
+		EstimatedAt: estimated_at,
+		Status:      of.Status.String(),
+		CreatedAt:   of.CreatedAt.String(),
+		UpdatedAt:   of.UpdatedAt.String(),
+	}
+}
+
+func transferData(t *ent.MasterTransfer) *orderextpbv1.MasterTransferData {
+	var decided_by string
+	if t.DecidedBy != uuid.Nil {
+		decided_by = t.DecidedBy.String()
+	}
+	return &orderextpbv1.MasterTransferData{
+		Id:           t.ID.String(),
+		OrderId:      t.OrderID.String(),
+		FromMasterId: t.FromMasterID.String(),
+		ToMasterId:   t.ToMasterID.String(),
+		Reason:       t.Reason,
+		Status:       t.Status.String(),
+		RequestedBy:  t.RequestedBy.String(),
+		DecidedBy:    decided_by,
+		CreatedAt:    t.CreatedAt.String(),
+		UpdatedAt:    t.UpdatedAt.String(),
+	}
+}
+
+// pageFromContext читает параметры страницы из метаданных запроса:
+// x-page-size, x-page-token и x-sort (newest, oldest, price_asc, price_desc).
+func pageFromContext(ctx context.Context) (PageRequest, error) {
+	var page PageRequest
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return page, nil
+	}
+	if vals := md.Get("x-page-size"); len(vals) > 0 {
+		size, err := strconv.Atoi(vals[0])
+		if err != nil || size < 0 {
+			return page, ErrInvalidPageSize
+		}
+		page.Size = size
+	}
+	if vals := md.Get("x-page-token"); len(vals) > 0 {
+		page.Cursor = vals[0]
+	}
+	if vals := md.Get("x-sort"); len(vals) > 0 {
+		sort, err := ParseSortOrder(vals[0])
+		if err != nil {
+			return page, err
+		}
+		page.Sort = sort
+	}
+	return page, nil
+}
+
+// setPageHeader отдаёт курсор следующей страницы и общее количество заказов
+// в заголовках ответа x-next-page-token и x-total-count.
+func setPageHeader(ctx context.Context, page *OrdersPage) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		"x-next-page-token", page.NextCursor,
+		"x-total-count", strconv.Itoa(page.Total),
+	))
+}
+
+// versionFromContext читает ожидаемую версию заказа из метаданных x-order-version.
+// 0 означает, что клиент не проверяет версию.
+func versionFromContext(ctx context.Context) (int, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	vals := md.Get("x-order-version")
+	if len(vals) == 0 {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(vals[0])
+	if err != nil || version < 0 {
+		return 0, ErrInvalidVersion
+	}
+	return version, nil
+}
+
+// updateMaskFromContext читает маску обновления (google.protobuf.FieldMask) из
+// метаданных x-update-mask: пути через запятую, например "title,price".
+// nil означает, что маска не задана.
+func updateMaskFromContext(ctx context.Context) *fieldmaskpb.FieldMask {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	vals := md.Get("x-update-mask")
+	if len(vals) == 0 {
+		return nil
+	}
+	mask := &fieldmaskpb.FieldMask{}
+	for _, v := range vals {
+		for _, path := range strings.Split(v, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				mask.Paths = append(mask.Paths, path)
+			}
+		}
+	}
+	return mask
+}
+
+// setVersionHeader отдаёт текущую версию заказа в заголовке ответа x-order-version.
+func setVersionHeader(ctx context.Context, o *ent.Order) error {
+	return grpc.SetHeader(ctx, metadata.Pairs("x-order-version", strconv.Itoa(o.Version)))
+}
+
+// requestedUser возвращает пользователя, чьи заказы запрошены: user_id из запроса
+// или, если он пуст, вызывающего. Чужие заказы доступны только администратору.
+func requestedUser(ctx context.Context, user_id string) (uuid.UUID, error) {
+	c, err := caller(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if user_id == "" {
+		return c.UserID, nil
+	}
+	id, err := uuid.Parse(user_id)
+	if err != nil {
+		return uuid.Nil, invalidField("user_id", "invalid UUID")
+	}
+	if err := authorizeUser(ctx, id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
 }
 
 func (s *Server) CreateOrder(ctx context.Context, req *orderpbv1.CreateOrderRequest) (*orderpbv1.CreateOrderResponse, error) {
-	client_id, err := uuid.Parse(req.ClientId)
+	in, err := validateCreateOrder(req)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID клиента")
+		return nil, err
 	}
+	client_id := in.ClientID
 
-	id, err := uuid.Parse(req.CategoryId)
+	idempotency_key, err := idempotencyKeyFromContext(ctx)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID категории")
+		return nil, err
+	}
+	var fingerprint string
+	if idempotency_key != "" {
+		if fingerprint, err = requestFingerprint(req); err != nil {
+			return nil, err
+		}
+	}
+
+	// Права проверяются до обращения к сервису пользователей, чтобы по ответу
+	// нельзя было узнать, существует ли чужой client_id.
+	if err := authorizeUser(ctx, client_id); err != nil {
+		return nil, err
+	}
+
+	// Клиент проверяется до сохранения: после него ошибки сервиса пользователей
+	// уже не должны приводить к ошибке RPC, иначе повтор запроса создаст дубликат.
+	if _, err := s.users.Get(ctx, client_id); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, invalidField("client_id", "клиент не найден")
+		}
+		return nil, err
 	}
 
 	order, err := s.svc.Create(ctx,
-		req.Title, req.Description, req.Address,
-		req.Longitude, req.Latitude, req.Status,
-		req.Price, id, client_id, uuid.Nil,
+		in.Title, in.Description, in.Address,
+		in.Longitude, in.Latitude, in.Status,
+		in.Price, in.CategoryID, client_id, uuid.Nil,
+		idempotency_key, fingerprint,
 	)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	clientRes, err := s.userSvc.GetUserById(ctx, &userpbv1.GetUserByIdRequest{UserId: req.ClientId})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := setVersionHeader(ctx, order); err != nil {
+		return nil, err
 	}
-	var master *commonpbv1.UserData
-	if req.MasterId != "" {
-		if mres, err := s.userSvc.GetUserById(ctx, &userpbv1.GetUserByIdRequest{UserId: req.MasterId}); err == nil {
-			master = mres.User
-		}
-	}
-	return &orderpbv1.CreateOrderResponse{Order: &commonpbv1.OrderData{
-		Id:          order.ID.String(),
-		Title:       order.Title,
-		Description: order.Description,
-		Address:     order.Address,
-		Longitude:   order.Longitude,
-		Latitude:    order.Latitude,
-		Status:      order.Status.String(),
-		Price:       order.Price,
-		CategoryId:  order.CategoryID.String(),
-		Client:      clientRes.User,
-		Master:      master,
-		CreatedAt:   order.CreatedAt.String(),
-		UpdatedAt:   order.UpdatedAt.String(),
-	}}, nil
+	return &orderpbv1.CreateOrderResponse{Order: s.users.OrderData(ctx, order)}, nil
 }
 
 func (s *Server) GetOrders(ctx context.Context, req *orderpbv1.GetOrdersRequest) (*orderpbv1.GetOrdersResponse, error) {
 	client_id, err := uuid.Parse(req.ClientId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID")
+		return nil, invalidField("client_id", "неправильный формат UUID")
 	}
 
 	master_id, err := uuid.Parse(req.MasterId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID")
+		return nil, invalidField("master_id", "неправильный формат UUID")
 	}
 
 	var categories_ids []uuid.UUID
@@ -83,7 +253,7 @@ func (s *Server) GetOrders(ctx context.Context, req *orderpbv1.GetOrdersRequest)
 		for _, id := range req.CategoriesIds {
 			cid, err := uuid.Parse(id)
 			if err != nil {
-				return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID категории")
+				return nil, invalidField("categories_ids", "неправильный формат UUID категории")
 			}
 			categories_ids = append(categories_ids, cid)
 		}
@@ -91,174 +261,473 @@ func (s *Server) GetOrders(ctx context.Context, req *orderpbv1.GetOrdersRequest)
 		categories_ids = nil
 	}
 
-	ents, err := s.svc.GetAll(ctx, categories_ids, req.Status, client_id, master_id)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	out := make([]*commonpbv1.OrderData, len(ents))
-	for i, o := range ents {
-		out[i] = &commonpbv1.OrderData{
-			Id:          o.ID.String(),
-			Title:       o.Title,
-			Description: o.Description,
-			Address:     o.Address,
-			Longitude:   o.Longitude,
-			Latitude:    o.Latitude,
-			Status:      o.Status.String(),
-			Price:       o.Price,
-			CategoryId:  o.CategoryID.String(),
-			CreatedAt:   o.CreatedAt.String(),
-			UpdatedAt:   o.UpdatedAt.String(),
-		}
+	page, err := pageFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.svc.GetAll(ctx, categories_ids, req.Status, client_id, master_id, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageHeader(ctx, res); err != nil {
+		return nil, err
 	}
-	return &orderpbv1.GetOrdersResponse{Orders: out}, nil
+	return &orderpbv1.GetOrdersResponse{Orders: s.users.OrdersData(ctx, res.Orders)}, nil
 }
 
 func (s *Server) GetOrderById(ctx context.Context, req *orderpbv1.GetOrderByIdRequest) (*orderpbv1.GetOrderByIdResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid UUID")
+		return nil, invalidField("id", "invalid UUID")
 	}
 	o, err := s.svc.Get(ctx, id)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	return &orderpbv1.GetOrderByIdResponse{Order: &commonpbv1.OrderData{
-		Id:          o.ID.String(),
-		Title:       o.Title,
-		Description: o.Description,
-		Address:     o.Address,
-		Longitude:   o.Longitude,
-		Latitude:    o.Latitude,
-		Status:      o.Status.String(),
-		Price:       o.Price,
-		Client:      &commonpbv1.UserData{Id: o.ClientID.String()},
-		CategoryId:  o.CategoryID.String(),
-		CreatedAt:   o.CreatedAt.String(),
-		UpdatedAt:   o.UpdatedAt.String(),
-	}}, nil
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderpbv1.GetOrderByIdResponse{Order: s.users.OrderData(ctx, o)}, nil
 }
 
+// UpdateOrder изменяет заказ. С маской x-update-mask записываются только
+// перечисленные поля, иначе — только непустые.
 func (s *Server) UpdateOrder(ctx context.Context, req *orderpbv1.UpdateOrderRequest) (*orderpbv1.GetOrderByIdResponse, error) {
-	client_id := uuid.Nil
-	master_id := uuid.Nil
-	category_id := uuid.Nil
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	mask := updateMaskFromContext(ctx)
+	patch, err := validateUpdateOrder(req, mask)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := versionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ord, err := s.svc.Update(ctx, id, version, patch, actorID(ctx), "")
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, ord); err != nil {
+		return nil, err
+	}
+	return &orderpbv1.GetOrderByIdResponse{Order: s.users.OrderData(ctx, ord)}, nil
+}
+
+func (s *Server) DeleteOrder(ctx context.Context, req *orderpbv1.DeleteOrderRequest) (*orderpbv1.DeleteOrderResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	version, err := versionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.svc.Delete(ctx, id, version, actorID(ctx)); err != nil {
+		return nil, err
+	}
+	return &orderpbv1.DeleteOrderResponse{}, nil
+}
 
-	if req.ClientId != "" {
-		client_id_p, err := uuid.Parse(req.ClientId)
+func (s *Server) GetMyOrders(ctx context.Context, req *orderpbv1.GetMyOrdersRequest) (*orderpbv1.GetMyOrdersResponse, error) {
+	id, err := requestedUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.svc.GetAll(ctx, nil, req.Status, id, uuid.Nil, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageHeader(ctx, res); err != nil {
+		return nil, err
+	}
+	return &orderpbv1.GetMyOrdersResponse{Orders: s.users.OrdersData(ctx, res.Orders)}, nil
+}
+
+func (s *Server) GetMyFinishedOrders(ctx context.Context, req *orderpbv1.GetMyFinishedOrdersRequest) (*orderpbv1.GetMyFinishedOrdersResponse, error) {
+	id, err := requestedUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	page, err := pageFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.svc.GetAll(ctx, nil, "done", id, uuid.Nil, page)
+	if err != nil {
+		return nil, err
+	}
+	if err := setPageHeader(ctx, res); err != nil {
+		return nil, err
+	}
+	return &orderpbv1.GetMyFinishedOrdersResponse{Orders: s.users.OrdersData(ctx, res.Orders)}, nil
+}
+
+func (s *Server) GetOrderTimeline(ctx context.Context, req *orderextpbv1.GetOrderTimelineRequest) (*orderextpbv1.GetOrderTimelineResponse, error) {
+	id, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, invalidField("order_id", "invalid UUID")
+	}
+	changes, err := s.svc.GetTimeline(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*orderextpbv1.OrderStatusChangeData, len(changes))
+	for i, c := range changes {
+		var from, actor string
+		if c.FromStatus != nil {
+			from = c.FromStatus.String()
+		}
+		if c.ActorID != uuid.Nil {
+			actor = c.ActorID.String()
+		}
+		out[i] = &orderextpbv1.OrderStatusChangeData{
+			Id:         c.ID.String(),
+			OrderId:    c.OrderID.String(),
+			FromStatus: from,
+			ToStatus:   c.ToStatus.String(),
+			ActorId:    actor,
+			Reason:     c.Reason,
+			CreatedAt:  c.CreatedAt.String(),
+		}
+	}
+	return &orderextpbv1.GetOrderTimelineResponse{Changes: out}, nil
+}
+
+func (s *Server) GetNearbyOrders(ctx context.Context, req *orderextpbv1.GetNearbyOrdersRequest) (*orderextpbv1.GetNearbyOrdersResponse, error) {
+	var categories_ids []uuid.UUID
+	for _, id := range req.CategoriesIds {
+		cid, err := uuid.Parse(id)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID клиента")
+			return nil, invalidField("categories_ids", "неправильный формат UUID категории")
 		}
-		client_id = client_id_p
+		categories_ids = append(categories_ids, cid)
 	}
 
-	if req.MasterId != "" {
-		master_id_p, err := uuid.Parse(req.ClientId)
+	nearby, err := s.svc.GetNearby(ctx, req.Longitude, req.Latitude, req.RadiusMeters, categories_ids, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]*ent.Order, len(nearby))
+	for i, n := range nearby {
+		orders[i] = n.Order
+	}
+	data := s.users.OrdersData(ctx, orders)
+	out := make([]*orderextpbv1.NearbyOrderData, len(nearby))
+	for i, n := range nearby {
+		out[i] = &orderextpbv1.NearbyOrderData{
+			Order:          data[i],
+			DistanceMeters: n.Distance,
+		}
+	}
+	return &orderextpbv1.GetNearbyOrdersResponse{Orders: out}, nil
+}
+
+func (s *Server) SearchOrders(ctx context.Context, req *orderextpbv1.SearchOrdersRequest) (*orderextpbv1.SearchOrdersResponse, error) {
+	var categories_ids []uuid.UUID
+	for _, id := range req.CategoriesIds {
+		cid, err := uuid.Parse(id)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID исполнителя")
+			return nil, invalidField("categories_ids", "неправильный формат UUID категории")
 		}
-		master_id = master_id_p
+		categories_ids = append(categories_ids, cid)
 	}
 
-	if req.CategoryId != "" {
-		category_id_p, err := uuid.Parse(req.CategoryId)
+	ents, err := s.svc.Search(ctx, req.Query, categories_ids, req.Status, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.SearchOrdersResponse{Orders: s.users.OrdersData(ctx, ents)}, nil
+}
+
+func (s *Server) CreateOffer(ctx context.Context, req *orderextpbv1.CreateOfferRequest) (*orderextpbv1.CreateOfferResponse, error) {
+	order_id, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, invalidField("order_id", "неправильный формат UUID заказа")
+	}
+	master_id, err := uuid.Parse(req.MasterId)
+	if err != nil {
+		return nil, invalidField("master_id", "неправильный формат UUID исполнителя")
+	}
+	var estimated_at *time.Time
+	if req.EstimatedAt != "" {
+		t, err := time.Parse(time.RFC3339, req.EstimatedAt)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "неправильный формат UUID категории")
+			return nil, invalidField("estimated_at", "неправильный формат даты выполнения")
 		}
-		category_id = category_id_p
+		estimated_at = &t
+	}
+
+	of, err := s.svc.CreateOffer(ctx, order_id, master_id, req.Price, req.Comment, estimated_at)
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.CreateOfferResponse{Offer: offerData(of)}, nil
+}
+
+func (s *Server) GetOrderOffers(ctx context.Context, req *orderextpbv1.GetOrderOffersRequest) (*orderextpbv1.GetOrderOffersResponse, error) {
+	order_id, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, invalidField("order_id", "неправильный формат UUID заказа")
+	}
+	offers, err := s.svc.GetOffers(ctx, order_id)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*orderextpbv1.OfferData, len(offers))
+	for i, of := range offers {
+		out[i] = offerData(of)
+	}
+	return &orderextpbv1.GetOrderOffersResponse{Offers: out}, nil
+}
+
+func (s *Server) AcceptOffer(ctx context.Context, req *orderextpbv1.AcceptOfferRequest) (*orderextpbv1.AcceptOfferResponse, error) {
+	offer_id, err := uuid.Parse(req.OfferId)
+	if err != nil {
+		return nil, invalidField("offer_id", "неправильный формат UUID предложения")
 	}
+	o, err := s.svc.AcceptOffer(ctx, offer_id, actorID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.AcceptOfferResponse{Order: s.users.OrderData(ctx, o)}, nil
+}
 
+func (s *Server) RejectOffer(ctx context.Context, req *orderextpbv1.RejectOfferRequest) (*orderextpbv1.RejectOfferResponse, error) {
+	offer_id, err := uuid.Parse(req.OfferId)
+	if err != nil {
+		return nil, invalidField("offer_id", "неправильный формат UUID предложения")
+	}
+	of, err := s.svc.RejectOffer(ctx, offer_id)
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.RejectOfferResponse{Offer: offerData(of)}, nil
+}
+
+func (s *Server) RestoreOrder(ctx context.Context, req *orderextpbv1.RestoreOrderRequest) (*orderextpbv1.RestoreOrderResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid UUID")
+		return nil, invalidField("id", "invalid UUID")
 	}
-	ord, err := s.svc.Update(ctx, id,
-		req.Title, req.Description, req.Address,
-		req.Longitude, req.Latitude, req.Status,
-		req.Price, category_id, client_id, master_id,
-	)
+	version, err := versionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.svc.Restore(ctx, id, version, actorID(ctx))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
 	}
-	return &orderpbv1.GetOrderByIdResponse{Order: &commonpbv1.OrderData{
-		Id:          ord.ID.String(),
-		Title:       ord.Title,
-		Description: ord.Description,
-		Address:     ord.Address,
-		Longitude:   ord.Longitude,
-		Latitude:    ord.Latitude,
-		Status:      ord.Status.String(),
-		Price:       ord.Price,
-		Client:      &commonpbv1.UserData{Id: ord.ClientID.String()},
-		CategoryId:  ord.CategoryID.String(),
-		CreatedAt:   ord.CreatedAt.String(),
-		UpdatedAt:   ord.UpdatedAt.String(),
-	}}, nil
+	return &orderextpbv1.RestoreOrderResponse{Order: s.users.OrderData(ctx, o)}, nil
 }
 
-func (s *Server) DeleteOrder(ctx context.Context, req *orderpbv1.DeleteOrderRequest) (*orderpbv1.DeleteOrderResponse, error) {
+func (s *Server) UnassignMaster(ctx context.Context, req *orderextpbv1.UnassignMasterRequest) (*orderextpbv1.UnassignMasterResponse, error) {
 	id, err := uuid.Parse(req.Id)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid UUID")
+		return nil, invalidField("id", "invalid UUID")
 	}
-	if err := s.svc.Delete(ctx, id); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
 	}
-	return &orderpbv1.DeleteOrderResponse{}, nil
+	o, err := s.svc.UnassignMaster(ctx, id, actorID(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.UnassignMasterResponse{Order: s.users.OrderData(ctx, o)}, nil
 }
 
-func (s *Server) GetMyOrders(ctx context.Context, req *orderpbv1.GetMyOrdersRequest) (*orderpbv1.GetMyOrdersResponse, error) {
-	id, err := uuid.Parse(req.UserId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid UUID")
-	}
-	ents, err := s.svc.GetAll(ctx, nil, req.Status, id, uuid.Nil)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	out := make([]*commonpbv1.OrderData, len(ents))
-	for i, o := range ents {
-		out[i] = &commonpbv1.OrderData{
-			Id:          o.ID.String(),
-			Title:       o.Title,
-			Description: o.Description,
-			Address:     o.Address,
-			Longitude:   o.Longitude,
-			Latitude:    o.Latitude,
-			Status:      o.Status.String(),
-			Price:       o.Price,
-			CategoryId:  o.CategoryID.String(),
-			CreatedAt:   o.CreatedAt.String(),
-			UpdatedAt:   o.UpdatedAt.String(),
-		}
+func (s *Server) ReassignOrderMaster(ctx context.Context, req *orderextpbv1.ReassignOrderMasterRequest) (*orderextpbv1.ReassignOrderMasterResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, invalidField("id", "invalid UUID")
+	}
+	master_id, err := uuid.Parse(req.MasterId)
+	if err != nil {
+		return nil, invalidField("master_id", "неправильный формат UUID исполнителя")
+	}
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMaster(ctx, s.users, "master_id", master_id); err != nil {
+		return nil, err
+	}
+	o, err := s.svc.ReassignOrderMaster(ctx, id, master_id, actorID(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
 	}
-	return &orderpbv1.GetMyOrdersResponse{Orders: out}, nil
+	return &orderextpbv1.ReassignOrderMasterResponse{Order: s.users.OrderData(ctx, o)}, nil
 }
 
-func (s *Server) GetMyFinishedOrders(ctx context.Context, req *orderpbv1.GetMyFinishedOrdersRequest) (*orderpbv1.GetMyFinishedOrdersResponse, error) {
-	id, err := uuid.Parse(req.UserId)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid UUID")
-	}
-	ents, err := s.svc.GetAll(ctx, nil, "done", id, uuid.Nil)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	out := make([]*commonpbv1.OrderData, len(ents))
-	for i, o := range ents {
-		out[i] = &commonpbv1.OrderData{
-			Id:          o.ID.String(),
-			Title:       o.Title,
-			Description: o.Description,
-			Address:     o.Address,
-			Longitude:   o.Longitude,
-			Latitude:    o.Latitude,
-			Status:      o.Status.String(),
-			Price:       o.Price,
-			CategoryId:  o.CategoryID.String(),
-			CreatedAt:   o.CreatedAt.String(),
-			UpdatedAt:   o.UpdatedAt.String(),
+func (s *Server) RequestMasterTransfer(ctx context.Context, req *orderextpbv1.RequestMasterTransferRequest) (*orderextpbv1.RequestMasterTransferResponse, error) {
+	order_id, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, invalidField("order_id", "неправильный формат UUID заказа")
+	}
+	to_master_id, err := uuid.Parse(req.ToMasterId)
+	if err != nil {
+		return nil, invalidField("to_master_id", "неправильный формат UUID исполнителя")
+	}
+	reason, err := parseReason(req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMaster(ctx, s.users, "to_master_id", to_master_id); err != nil {
+		return nil, err
+	}
+	t, err := s.svc.RequestTransfer(ctx, order_id, to_master_id, actorID(ctx), reason)
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.RequestMasterTransferResponse{Transfer: transferData(t)}, nil
+}
+
+func (s *Server) AcceptMasterTransfer(ctx context.Context, req *orderextpbv1.AcceptMasterTransferRequest) (*orderextpbv1.AcceptMasterTransferResponse, error) {
+	transfer_id, err := uuid.Parse(req.TransferId)
+	if err != nil {
+		return nil, invalidField("transfer_id", "неправильный формат UUID передачи")
+	}
+	o, err := s.svc.AcceptTransfer(ctx, transfer_id, actorID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if err := setVersionHeader(ctx, o); err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.AcceptMasterTransferResponse{Order: s.users.OrderData(ctx, o)}, nil
+}
+
+func (s *Server) DeclineMasterTransfer(ctx context.Context, req *orderextpbv1.DeclineMasterTransferRequest) (*orderextpbv1.DeclineMasterTransferResponse, error) {
+	transfer_id, err := uuid.Parse(req.TransferId)
+	if err != nil {
+		return nil, invalidField("transfer_id", "неправильный формат UUID передачи")
+	}
+	t, err := s.svc.DeclineTransfer(ctx, transfer_id, actorID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &orderextpbv1.DeclineMasterTransferResponse{Transfer: transferData(t)}, nil
+}
+
+func (s *Server) WatchOrders(req *orderextpbv1.WatchOrdersRequest, stream orderextpbv1.OrderExtService_WatchOrdersServer) error {
+	var filter func(*OrderChange) bool
+	switch {
+	case req.MasterId != "":
+		master_id, err := uuid.Parse(req.MasterId)
+		if err != nil {
+			return invalidField("master_id", "неправильный формат UUID исполнителя")
+		}
+		if err := authorizeUser(stream.Context(), master_id); err != nil {
+			return err
+		}
+		filter = MasterOrdersFilter(master_id)
+	case req.ClientId != "":
+		client_id, err := uuid.Parse(req.ClientId)
+		if err != nil {
+			return invalidField("client_id", "неправильный формат UUID клиента")
+		}
+		if err := authorizeUser(stream.Context(), client_id); err != nil {
+			return err
+		}
+		filter = ClientOrdersFilter(client_id)
+	default:
+		if err := authorizeFeed(stream.Context()); err != nil {
+			return err
+		}
+		var categories_ids []uuid.UUID
+		for _, id := range req.CategoriesIds {
+			cid, err := uuid.Parse(id)
+			if err != nil {
+				return invalidField("categories_ids", "неправильный формат UUID категории")
+			}
+			categories_ids = append(categories_ids, cid)
 		}
+		filter = ActiveOrdersFilter(categories_ids)
+	}
+
+	sub := s.watch.Subscribe(filter)
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case change, ok := <-sub.C:
+			if !ok {
+				if sub.Lagged() {
+					return status.Error(codes.ResourceExhausted, "клиент не успевает получать изменения, переподключитесь")
+				}
+				return status.Error(codes.Unavailable, "подписка закрыта")
+			}
+			if err := stream.Send(s.orderChangeEvent(stream.Context(), &change)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// orderChangeEvent переводит изменение заказа в сообщение потока WatchOrders.
+func (s *Server) orderChangeEvent(ctx context.Context, c *OrderChange) *orderextpbv1.OrderChangeEvent {
+	ev := &c.Event
+	ids := []uuid.UUID{ev.ClientID}
+	if ev.MasterID != nil {
+		ids = append(ids, *ev.MasterID)
+	}
+	users := s.users.Resolve(ctx, ids)
+	data := &commonpbv1.OrderData{
+		Id:          ev.OrderID.String(),
+		Title:       ev.Title,
+		Description: ev.Description,
+		Address:     ev.Address,
+		Longitude:   FormatCoordinate(ev.Longitude),
+		Latitude:    FormatCoordinate(ev.Latitude),
+		Status:      ev.Status,
+		Price:       ev.Price,
+		CategoryId:  ev.CategoryID.String(),
+		Client:      users[ev.ClientID],
+		CreatedAt:   ev.CreatedAt.String(),
+		UpdatedAt:   ev.UpdatedAt.String(),
+	}
+	if ev.MasterID != nil {
+		data.Master = users[*ev.MasterID]
+	}
+	out := &orderextpbv1.OrderChangeEvent{
+		EventId:        c.EventID.String(),
+		Type:           c.Type.String(),
+		Order:          data,
+		PreviousStatus: ev.PreviousStatus,
+		OccurredAt:     ev.OccurredAt.Format(time.RFC3339Nano),
+		Reason:         ev.Reason,
+	}
+	if ev.PreviousMasterID != nil {
+		out.PreviousMasterId = ev.PreviousMasterID.String()
+	}
+	if ev.TransferMasterID != nil {
+		out.TransferMasterId = ev.TransferMasterID.String()
+	}
+	if ev.ActorID != nil {
+		out.ActorId = ev.ActorID.String()
 	}
-	return &orderpbv1.GetMyFinishedOrdersResponse{Orders: out}, nil
+	return out
 }
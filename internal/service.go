@@ -2,48 +2,397 @@ package order
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
 
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
 	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
 	"github.com/google/uuid"
 )
 
+var (
+	ErrInvalidOfferPrice  = errors.New("цена предложения не может быть отрицательной")
+	ErrInvalidEstimatedAt = errors.New("ожидаемая дата выполнения уже прошла")
+)
+
 type Service interface {
 	Get(ctx context.Context, id uuid.UUID) (*ent.Order, error)
-	GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id uuid.UUID, master_id uuid.UUID) ([]*ent.Order, error)
+	GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id uuid.UUID, master_id uuid.UUID, page PageRequest) (*OrdersPage, error)
 	GetAllActive(ctx context.Context, categories_ids []uuid.UUID, client_id, master_id uuid.UUID) ([]*ent.Order, error)
-	Create(ctx context.Context, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id, master_id uuid.UUID) (*ent.Order, error)
-	Update(ctx context.Context, id uuid.UUID, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id, master_id uuid.UUID) (*ent.Order, error)
-	Delete(ctx context.Context, id uuid.UUID) error
+	GetNearby(ctx context.Context, longitude, latitude, radius float64, categories_ids []uuid.UUID, limit int) ([]*NearbyOrder, error)
+	Search(ctx context.Context, query string, categories_ids []uuid.UUID, status string, limit, offset int) ([]*ent.Order, error)
+	Create(ctx context.Context, title, description, address string, longitude, latitude float64, status string, price float32, category_id uuid.UUID, client_id, master_id uuid.UUID, idempotency_key, fingerprint string) (*ent.Order, error)
+	Update(ctx context.Context, id uuid.UUID, version int, patch *OrderPatch, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	Delete(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) (*ent.Order, error)
+	Purge(ctx context.Context, retention time.Duration) (int, error)
+	GetTimeline(ctx context.Context, order_id uuid.UUID) ([]*ent.OrderStatusChange, error)
+	CreateOffer(ctx context.Context, order_id, master_id uuid.UUID, price float32, comment string, estimated_at *time.Time) (*ent.Offer, error)
+	GetOffers(ctx context.Context, order_id uuid.UUID) ([]*ent.Offer, error)
+	AcceptOffer(ctx context.Context, offer_id, actor_id uuid.UUID) (*ent.Order, error)
+	RejectOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error)
+	UnassignMaster(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	ReassignOrderMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	RequestTransfer(ctx context.Context, order_id, to_master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.MasterTransfer, error)
+	AcceptTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.Order, error)
+	DeclineTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.MasterTransfer, error)
+
+	// Операции поддержки; доступ к ним ограничивает политика методов.
+	ListAll(ctx context.Context, filter OrderFilter, page PageRequest) (*OrdersPage, error)
+	ForceStatus(ctx context.Context, id uuid.UUID, status string, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	ReassignMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error)
+	HardDelete(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) error
 }
 
 type service struct {
-	repo Repoistory
+	repo   Repoistory
+	policy *auth.Policy
 }
 
-func NewService(r Repoistory) Service {
-	return &service{repo: r}
+// NewService создаёт сервис заказов. Переходы статусов разрешаются ролям по policy.
+func NewService(r Repoistory, policy *auth.Policy) Service {
+	return &service{repo: r, policy: policy}
 }
 
 func (s *service) Get(ctx context.Context, id uuid.UUID) (*ent.Order, error) {
-	return s.repo.Get(ctx, id)
+	o, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeRead(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
 }
 
-func (s *service) GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id, master_id uuid.UUID) ([]*ent.Order, error) {
-	return s.repo.GetAll(ctx, categories_ids, status, client_id, master_id)
+func (s *service) GetAll(ctx context.Context, categories_ids []uuid.UUID, status string, client_id, master_id uuid.UUID, page PageRequest) (*OrdersPage, error) {
+	if err := authorizeList(ctx, client_id, master_id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAll(ctx, categories_ids, status, client_id, master_id, page)
 }
 
 func (s *service) GetAllActive(ctx context.Context, categories_ids []uuid.UUID, client_id, master_id uuid.UUID) ([]*ent.Order, error) {
 	return s.repo.GetAllActive(ctx, categories_ids)
 }
 
-func (s *service) Create(ctx context.Context, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID) (*ent.Order, error) {
-	return s.repo.Create(ctx, title, description, address, longitude, latitude, status, price, category_id, client_id, master_id)
+// GetNearby возвращает активные заказы рядом с точкой. Это та же лента, что и
+// в WatchOrders, поэтому доступна она тем же ролям.
+func (s *service) GetNearby(ctx context.Context, longitude, latitude, radius float64, categories_ids []uuid.UUID, limit int) ([]*NearbyOrder, error) {
+	if err := authorizeFeed(ctx); err != nil {
+		return nil, err
+	}
+	if err := validateCoordinates(longitude, latitude); err != nil {
+		return nil, err
+	}
+	if radius <= 0 || radius > MaxNearbyRadius {
+		return nil, ErrInvalidRadius
+	}
+	if limit <= 0 || limit > MaxNearbyLimit {
+		limit = MaxNearbyLimit
+	}
+	return s.repo.GetNearby(ctx, longitude, latitude, radius, categories_ids, limit)
+}
+
+func (s *service) Search(ctx context.Context, query string, categories_ids []uuid.UUID, status string, limit, offset int) ([]*ent.Order, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if runes := []rune(query); len(runes) > MaxSearchQueryLen {
+		query = string(runes[:MaxSearchQueryLen])
+	}
+	if status != "" {
+		if _, err := parseStatus(status); err != nil {
+			return nil, err
+		}
+	}
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	c, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Search(ctx, query, categories_ids, status, readableOrders(c), limit, offset)
+}
+
+func (s *service) Create(ctx context.Context, title, description, address string, longitude, latitude float64, status string, price float32, category_id uuid.UUID, client_id uuid.UUID, master_id uuid.UUID, idempotency_key, fingerprint string) (*ent.Order, error) {
+	if err := validateCoordinates(longitude, latitude); err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, title, description, address, longitude, latitude, status, price, category_id, client_id, master_id, idempotency_key, fingerprint)
+}
+
+// Update изменяет заказ. Отметить заказ выполненным может только назначенный
+// исполнитель, всё остальное — только клиент заказа; администратор может всё.
+func (s *service) Update(ctx context.Context, id uuid.UUID, version int, patch *OrderPatch, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	if err := validateCoordinates(patch.Longitude, patch.Latitude); err != nil {
+		return nil, err
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	markDone := false
+	if patch.Has(PathStatus) {
+		to, err := parseStatus(patch.Status)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkTransition(current.Status, to); err != nil {
+			return nil, err
+		}
+		// В работу заказ переходит вместе с назначением исполнителя — при принятии предложения.
+		if to == order.StatusInProgress && current.Status != order.StatusInProgress {
+			return nil, invalidField(PathStatus, "заказ переходит в работу при принятии предложения исполнителя")
+		}
+		if err := authorizeTransition(ctx, s.policy, current.Status, to); err != nil {
+			return nil, err
+		}
+		markDone = to == order.StatusDone && current.Status != order.StatusDone
+		// Переход проверен для прочитанной версии — не даём записать его поверх более новой
+		if version == 0 {
+			version = current.Version
+		}
+	}
+
+	edits := patch.Edits()
+	if markDone {
+		if err := authorizeMaster(ctx, current); err != nil {
+			return nil, err
+		}
+	}
+	if !markDone || edits {
+		if err := authorizeOwner(ctx, current); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.Update(ctx, id, version, patch, actor_id, reason)
+}
+
+func (s *service) Delete(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) error {
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeOwner(ctx, current); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id, version, actor_id)
+}
+
+func (s *service) Restore(ctx context.Context, id uuid.UUID, version int, actor_id uuid.UUID) (*ent.Order, error) {
+	current, err := s.repo.GetIncludingDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, current); err != nil {
+		return nil, err
+	}
+	return s.repo.Restore(ctx, id, version, actor_id)
+}
+
+func (s *service) Purge(ctx context.Context, retention time.Duration) (int, error) {
+	return s.repo.Purge(ctx, time.Now().Add(-retention))
+}
+
+func (s *service) GetTimeline(ctx context.Context, order_id uuid.UUID) ([]*ent.OrderStatusChange, error) {
+	o, err := s.repo.GetIncludingDeleted(ctx, order_id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeRead(ctx, o); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTimeline(ctx, order_id)
+}
+
+func (s *service) CreateOffer(ctx context.Context, order_id, master_id uuid.UUID, price float32, comment string, estimated_at *time.Time) (*ent.Offer, error) {
+	if err := authorizeUser(ctx, master_id); err != nil {
+		return nil, err
+	}
+	if price < 0 {
+		return nil, ErrInvalidOfferPrice
+	}
+	if estimated_at != nil && estimated_at.Before(time.Now()) {
+		return nil, ErrInvalidEstimatedAt
+	}
+	return s.repo.CreateOffer(ctx, order_id, master_id, price, comment, estimated_at)
+}
+
+// GetOffers возвращает предложения по заказу. Клиент заказа, модератор и
+// администратор видят все предложения, исполнитель — только своё.
+func (s *service) GetOffers(ctx context.Context, order_id uuid.UUID) ([]*ent.Offer, error) {
+	c, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.repo.Get(ctx, order_id)
+	if err != nil {
+		return nil, err
+	}
+	if !canModerate(c) && c.UserID != o.ClientID && c.Role != auth.RoleMaster {
+		return nil, ErrNotOrderParticipant
+	}
+	offers, err := s.repo.GetOffers(ctx, order_id)
+	if err != nil {
+		return nil, err
+	}
+	if canModerate(c) || c.UserID == o.ClientID {
+		return offers, nil
+	}
+	own := offers[:0]
+	for _, of := range offers {
+		if of.MasterID == c.UserID {
+			own = append(own, of)
+		}
+	}
+	return own, nil
+}
+
+func (s *service) AcceptOffer(ctx context.Context, offer_id, actor_id uuid.UUID) (*ent.Order, error) {
+	if err := s.authorizeOfferOwner(ctx, offer_id); err != nil {
+		return nil, err
+	}
+	if err := authorizeTransition(ctx, s.policy, order.StatusActive, order.StatusInProgress); err != nil {
+		return nil, err
+	}
+	return s.repo.AcceptOffer(ctx, offer_id, actor_id)
+}
+
+func (s *service) RejectOffer(ctx context.Context, offer_id uuid.UUID) (*ent.Offer, error) {
+	if err := s.authorizeOfferOwner(ctx, offer_id); err != nil {
+		return nil, err
+	}
+	return s.repo.RejectOffer(ctx, offer_id)
+}
+
+// UnassignMaster снимает исполнителя с заказа в работе. Это могут сделать
+// клиент заказа и сам исполнитель.
+func (s *service) UnassignMaster(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeParticipant(ctx, current); err != nil {
+		return nil, err
+	}
+	return s.repo.UnassignMaster(ctx, id, actor_id, reason)
+}
+
+// ReassignOrderMaster передаёт заказ в работе другому исполнителю по решению клиента.
+func (s *service) ReassignOrderMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, current); err != nil {
+		return nil, err
+	}
+	if current.Status != order.StatusInProgress {
+		return nil, ErrOrderNotInProgress
+	}
+	if current.MasterID == master_id {
+		return nil, invalidField("master_id", "заказ уже у этого исполнителя")
+	}
+	return s.repo.ReassignMaster(ctx, id, master_id, actor_id, reason)
+}
+
+// RequestTransfer предлагает передать заказ другому исполнителю. Запросить
+// передачу может только назначенный исполнитель.
+func (s *service) RequestTransfer(ctx context.Context, order_id, to_master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.MasterTransfer, error) {
+	o, err := s.repo.Get(ctx, order_id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeMaster(ctx, o); err != nil {
+		return nil, err
+	}
+	if o.MasterID == to_master_id {
+		return nil, invalidField("to_master_id", "нельзя передать заказ самому себе")
+	}
+	return s.repo.RequestTransfer(ctx, order_id, to_master_id, actor_id, reason)
+}
+
+// AcceptTransfer принимает передачу заказа; это может сделать только исполнитель,
+// которому заказ передаётся.
+func (s *service) AcceptTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.Order, error) {
+	t, err := s.repo.GetTransfer(ctx, transfer_id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUser(ctx, t.ToMasterID); err != nil {
+		return nil, err
+	}
+	return s.repo.AcceptTransfer(ctx, transfer_id, actor_id)
+}
+
+func (s *service) DeclineTransfer(ctx context.Context, transfer_id, actor_id uuid.UUID) (*ent.MasterTransfer, error) {
+	t, err := s.repo.GetTransfer(ctx, transfer_id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeUser(ctx, t.ToMasterID); err != nil {
+		return nil, err
+	}
+	return s.repo.DeclineTransfer(ctx, transfer_id, actor_id)
+}
+
+func (s *service) ListAll(ctx context.Context, filter OrderFilter, page PageRequest) (*OrdersPage, error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	if runes := []rune(filter.Query); len(runes) > MaxSearchQueryLen {
+		filter.Query = string(runes[:MaxSearchQueryLen])
+	}
+	if filter.MinPrice < 0 {
+		return nil, invalidField("min_price", "цена не может быть отрицательной")
+	}
+	if filter.MaxPrice < 0 {
+		return nil, invalidField("max_price", "цена не может быть отрицательной")
+	}
+	if filter.MaxPrice > 0 && filter.MinPrice > filter.MaxPrice {
+		return nil, invalidField("max_price", "максимальная цена меньше минимальной")
+	}
+	if !filter.CreatedAfter.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedAfter.Before(filter.CreatedBefore) {
+		return nil, invalidField("created_before", "конец периода раньше его начала")
+	}
+	return s.repo.List(ctx, filter, page)
+}
+
+func (s *service) ForceStatus(ctx context.Context, id uuid.UUID, status string, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	to, err := parseStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ForceStatus(ctx, id, to, actor_id, reason)
+}
+
+func (s *service) ReassignMaster(ctx context.Context, id, master_id uuid.UUID, actor_id uuid.UUID, reason string) (*ent.Order, error) {
+	return s.repo.ReassignMaster(ctx, id, master_id, actor_id, reason)
 }
 
-func (s *service) Update(ctx context.Context, id uuid.UUID, title, description, address, longitude, latitude, status string, price float32, category_id uuid.UUID, client_id, master_id uuid.UUID) (*ent.Order, error) {
-	return s.repo.Update(ctx, id, title, description, address, longitude, latitude, status, price, category_id, client_id, master_id)
+func (s *service) HardDelete(ctx context.Context, id uuid.UUID, actor_id uuid.UUID, reason string) error {
+	return s.repo.HardDelete(ctx, id, actor_id, reason)
 }
 
-func (s *service) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.repo.Delete(ctx, id)
+// authorizeOfferOwner разрешает рассматривать предложение только клиенту заказа.
+func (s *service) authorizeOfferOwner(ctx context.Context, offer_id uuid.UUID) error {
+	of, err := s.repo.GetOffer(ctx, offer_id)
+	if err != nil {
+		return err
+	}
+	o, err := s.repo.Get(ctx, of.OrderID)
+	if err != nil {
+		return err
+	}
+	return authorizeOwner(ctx, o)
 }
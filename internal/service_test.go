@@ -0,0 +1,149 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// fakeRepo — хранилище для тестов сервиса. Методы, которые тест не
+// переопределяет, паникуют через встроенный nil-интерфейс.
+type fakeRepo struct {
+	Repoistory
+	searchVisible predicate.Order
+	searchCalled  bool
+}
+
+func (r *fakeRepo) Search(ctx context.Context, query string, categories_ids []uuid.UUID, status string, visible predicate.Order, limit, offset int) ([]*ent.Order, error) {
+	r.searchCalled = true
+	r.searchVisible = visible
+	return nil, nil
+}
+
+func (r *fakeRepo) GetNearby(ctx context.Context, longitude, latitude, radius float64, categories_ids []uuid.UUID, limit int) ([]*NearbyOrder, error) {
+	return nil, nil
+}
+
+func asUser(role auth.Role) (context.Context, uuid.UUID) {
+	id := uuid.New()
+	return auth.NewContext(context.Background(), auth.Identity{UserID: id, Role: role}), id
+}
+
+// whereSQL возвращает условие WHERE, которое p добавляет к выборке заказов.
+func whereSQL(p predicate.Order) (string, []any) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(order.Table))
+	p(s)
+	query, args := s.Query()
+	return query, args
+}
+
+func TestAuthorizeRead(t *testing.T) {
+	owner, master := uuid.New(), uuid.New()
+	done := &ent.Order{ClientID: owner, MasterID: master, Status: order.StatusDone}
+	active := &ent.Order{ClientID: owner, Status: order.StatusActive}
+
+	tests := []struct {
+		name string
+		c    auth.Identity
+		o    *ent.Order
+		ok   bool
+	}{
+		{"owner", auth.Identity{UserID: owner, Role: auth.RoleClient}, done, true},
+		{"assigned master", auth.Identity{UserID: master, Role: auth.RoleMaster}, done, true},
+		{"other client, done order", auth.Identity{UserID: uuid.New(), Role: auth.RoleClient}, done, false},
+		{"other client, active order", auth.Identity{UserID: uuid.New(), Role: auth.RoleClient}, active, false},
+		{"other master, done order", auth.Identity{UserID: uuid.New(), Role: auth.RoleMaster}, done, false},
+		{"other master, active order", auth.Identity{UserID: uuid.New(), Role: auth.RoleMaster}, active, true},
+		{"moderator", auth.Identity{UserID: uuid.New(), Role: auth.RoleModerator}, done, true},
+		{"admin", auth.Identity{UserID: uuid.New(), Role: auth.RoleAdmin}, done, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizeRead(auth.NewContext(context.Background(), tt.c), tt.o)
+			if tt.ok && err != nil {
+				t.Errorf("authorizeRead() = %v, want nil", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrNotOrderParticipant) {
+				t.Errorf("authorizeRead() = %v, want ErrNotOrderParticipant", err)
+			}
+		})
+	}
+}
+
+func TestSearchLimitsResultsToReadableOrders(t *testing.T) {
+	tests := []struct {
+		role auth.Role
+		want string
+	}{
+		{auth.RoleClient, `SELECT * FROM "orders" WHERE "orders"."client_id" = $1 OR "orders"."master_id" = $2`},
+		{auth.RoleMaster, `SELECT * FROM "orders" WHERE "orders"."client_id" = $1 OR "orders"."master_id" = $2 OR "orders"."status" = $3`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			ctx, id := asUser(tt.role)
+			repo := &fakeRepo{}
+			// Клиент ищет выполненные заказы: чужие в выдачу попасть не должны.
+			if _, err := NewService(repo, nil).Search(ctx, "шкаф", nil, "done", 0, 0); err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if repo.searchVisible == nil {
+				t.Fatal("Search() passed no visibility restriction")
+			}
+			query, args := whereSQL(repo.searchVisible)
+			if query != tt.want {
+				t.Errorf("visible = %s, want %s", query, tt.want)
+			}
+			if args[0] != id || args[1] != id {
+				t.Errorf("visible args = %v, want caller %s", args, id)
+			}
+		})
+	}
+}
+
+func TestSearchUnrestrictedForModerators(t *testing.T) {
+	for _, role := range []auth.Role{auth.RoleModerator, auth.RoleAdmin} {
+		ctx, _ := asUser(role)
+		repo := &fakeRepo{}
+		if _, err := NewService(repo, nil).Search(ctx, "шкаф", nil, "done", 0, 0); err != nil {
+			t.Fatalf("Search() as %s error = %v", role, err)
+		}
+		if repo.searchVisible != nil {
+			t.Errorf("Search() as %s restricted results", role)
+		}
+	}
+}
+
+func TestSearchRequiresCaller(t *testing.T) {
+	repo := &fakeRepo{}
+	_, err := NewService(repo, nil).Search(context.Background(), "шкаф", nil, "", 0, 0)
+	if !errors.Is(err, auth.ErrUnauthenticated) || repo.searchCalled {
+		t.Errorf("Search() without caller = %v, repo called %v", err, repo.searchCalled)
+	}
+}
+
+func TestGetNearbyIsFeed(t *testing.T) {
+	tests := []struct {
+		role auth.Role
+		err  error
+	}{
+		{auth.RoleClient, ErrFeedNotAllowed},
+		{auth.RoleMaster, nil},
+		{auth.RoleModerator, nil},
+		{auth.RoleAdmin, nil},
+	}
+	for _, tt := range tests {
+		ctx, _ := asUser(tt.role)
+		_, err := NewService(&fakeRepo{}, nil).GetNearby(ctx, 37.6, 55.7, 1000, nil, 10)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("GetNearby() as %s = %v, want %v", tt.role, err, tt.err)
+		}
+	}
+}
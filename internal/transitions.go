@@ -0,0 +1,64 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+)
+
+var ErrInvalidStatus = errors.New("неизвестный статус заказа")
+
+// ErrInvalidTransition — недопустимый переход между статусами заказа.
+type ErrInvalidTransition struct {
+	From order.Status
+	To   order.Status
+}
+
+func (e *ErrInvalidTransition) Error() string {
+	return fmt.Sprintf("недопустимый переход статуса заказа: %s -> %s", e.From, e.To)
+}
+
+// transitions — таблица допустимых переходов статусов заказа.
+// cancel и done — терминальные статусы, из них переходов нет.
+var transitions = map[order.Status][]order.Status{
+	order.StatusActive:     {order.StatusInProgress, order.StatusCancel},
+	order.StatusInProgress: {order.StatusDone, order.StatusCancel},
+	order.StatusCancel:     nil,
+	order.StatusDone:       nil,
+}
+
+// Transitions возвращает таблицу допустимых переходов статусов для сверки с
+// политикой доступа.
+func Transitions() map[string][]string {
+	out := make(map[string][]string, len(transitions))
+	for from, tos := range transitions {
+		for _, to := range tos {
+			out[from.String()] = append(out[from.String()], to.String())
+		}
+	}
+	return out
+}
+
+// parseStatus проверяет, что строка является допустимым статусом заказа.
+func parseStatus(status string) (order.Status, error) {
+	s := order.Status(status)
+	if err := order.StatusValidator(s); err != nil {
+		return "", ErrInvalidStatus
+	}
+	return s, nil
+}
+
+// checkTransition возвращает ErrInvalidTransition, если переход from -> to запрещён.
+// Переход в тот же статус считается допустимым и ничего не меняет.
+func checkTransition(from, to order.Status) error {
+	if from == to {
+		return nil
+	}
+	for _, s := range transitions[from] {
+		if s == to {
+			return nil
+		}
+	}
+	return &ErrInvalidTransition{From: from, To: to}
+}
@@ -0,0 +1,89 @@
+package order
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+)
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		from, to order.Status
+		ok       bool
+	}{
+		{order.StatusActive, order.StatusActive, true},
+		{order.StatusActive, order.StatusInProgress, true},
+		{order.StatusActive, order.StatusCancel, true},
+		{order.StatusActive, order.StatusDone, false},
+
+		{order.StatusInProgress, order.StatusInProgress, true},
+		{order.StatusInProgress, order.StatusDone, true},
+		{order.StatusInProgress, order.StatusCancel, true},
+		{order.StatusInProgress, order.StatusActive, false},
+
+		{order.StatusDone, order.StatusDone, true},
+		{order.StatusDone, order.StatusActive, false},
+		{order.StatusDone, order.StatusInProgress, false},
+		{order.StatusDone, order.StatusCancel, false},
+
+		{order.StatusCancel, order.StatusCancel, true},
+		{order.StatusCancel, order.StatusActive, false},
+		{order.StatusCancel, order.StatusInProgress, false},
+		{order.StatusCancel, order.StatusDone, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"->"+tt.to.String(), func(t *testing.T) {
+			err := checkTransition(tt.from, tt.to)
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("checkTransition() = %v, want nil", err)
+				}
+				return
+			}
+			var invalid *ErrInvalidTransition
+			if !errors.As(err, &invalid) {
+				t.Fatalf("checkTransition() = %v, want *ErrInvalidTransition", err)
+			}
+			if invalid.From != tt.from || invalid.To != tt.to {
+				t.Errorf("ErrInvalidTransition = %s -> %s, want %s -> %s", invalid.From, invalid.To, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	got := Transitions()
+	want := map[string][]string{
+		"active":      {"cancel", "in_progress"},
+		"in_progress": {"cancel", "done"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Transitions() = %v, want %v", got, want)
+	}
+	for from, tos := range want {
+		sorted := slices.Sorted(slices.Values(got[from]))
+		if !slices.Equal(sorted, tos) {
+			t.Errorf("Transitions()[%q] = %v, want %v", from, sorted, tos)
+		}
+	}
+	for _, terminal := range []string{"done", "cancel"} {
+		if tos, ok := got[terminal]; ok {
+			t.Errorf("Transitions()[%q] = %v, want no transitions", terminal, tos)
+		}
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for _, s := range []string{"active", "in_progress", "cancel", "done"} {
+		if got, err := parseStatus(s); err != nil || got.String() != s {
+			t.Errorf("parseStatus(%q) = %q, %v", s, got, err)
+		}
+	}
+	for _, s := range []string{"", "Active", "closed", "in progress"} {
+		if _, err := parseStatus(s); !errors.Is(err, ErrInvalidStatus) {
+			t.Errorf("parseStatus(%q) error = %v, want ErrInvalidStatus", s, err)
+		}
+	}
+}
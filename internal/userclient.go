@@ -0,0 +1,241 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	userpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/user/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultUserCallTimeout      = time.Second
+	DefaultUserCallMaxAttempts  = 3
+	DefaultUserRetryBaseDelay   = 50 * time.Millisecond
+	DefaultUserRetryMaxDelay    = time.Second
+	DefaultUserBreakerThreshold = 5
+	DefaultUserBreakerCooldown  = 30 * time.Second
+)
+
+var (
+	ErrUserServiceUnavailable = errors.New("сервис пользователей недоступен")
+	ErrUserNotFound           = errors.New("пользователь не найден")
+	ErrUserNotMaster          = errors.New("пользователь не является исполнителем")
+)
+
+// ResilientUserClient — клиент сервиса пользователей с таймаутом на вызов,
+// повторами с джиттером для временных ошибок и автоматическим выключателем.
+// Повторяются только читающие методы; изменяющие вызываются один раз.
+//
+// Выключатель размыкается после BreakerThreshold подряд неудачных вызовов и
+// BreakerCooldown отклоняет запросы с ErrUserServiceUnavailable, после чего
+// пропускает один пробный вызов.
+type ResilientUserClient struct {
+	userpbv1.UserServiceClient
+
+	Timeout          time.Duration
+	MaxAttempts      int
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+
+	stats UserClientStats
+}
+
+// UserClientStats — счётчики вызовов сервиса пользователей.
+type UserClientStats struct {
+	Calls        atomic.Int64
+	Retries      atomic.Int64
+	Failures     atomic.Int64
+	Rejected     atomic.Int64
+	BreakerOpens atomic.Int64
+}
+
+func NewResilientUserClient(client userpbv1.UserServiceClient) *ResilientUserClient {
+	return &ResilientUserClient{
+		UserServiceClient: client,
+		Timeout:           DefaultUserCallTimeout,
+		MaxAttempts:       DefaultUserCallMaxAttempts,
+		BaseDelay:         DefaultUserRetryBaseDelay,
+		MaxDelay:          DefaultUserRetryMaxDelay,
+		BreakerThreshold:  DefaultUserBreakerThreshold,
+		BreakerCooldown:   DefaultUserBreakerCooldown,
+	}
+}
+
+// Stats возвращает счётчики вызовов клиента.
+func (c *ResilientUserClient) Stats() *UserClientStats {
+	return &c.stats
+}
+
+// BreakerOpen сообщает, разомкнут ли выключатель.
+func (c *ResilientUserClient) BreakerOpen() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return time.Now().Before(c.openUntil)
+}
+
+func (c *ResilientUserClient) GetUserById(ctx context.Context, in *userpbv1.GetUserByIdRequest, opts ...grpc.CallOption) (*userpbv1.GetUserByIdResponse, error) {
+	var res *userpbv1.GetUserByIdResponse
+	err := c.call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.UserServiceClient.GetUserById(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *ResilientUserClient) GetUsers(ctx context.Context, in *userpbv1.GetUsersRequest, opts ...grpc.CallOption) (*userpbv1.GetUsersResponse, error) {
+	var res *userpbv1.GetUsersResponse
+	err := c.call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.UserServiceClient.GetUsers(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *ResilientUserClient) ValidateCredentials(ctx context.Context, in *userpbv1.ValidateCredentialsRequest, opts ...grpc.CallOption) (*userpbv1.ValidateCredentialsResponse, error) {
+	var res *userpbv1.ValidateCredentialsResponse
+	err := c.call(ctx, true, func(ctx context.Context) (err error) {
+		res, err = c.UserServiceClient.ValidateCredentials(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *ResilientUserClient) CreateUser(ctx context.Context, in *userpbv1.CreateUserRequest, opts ...grpc.CallOption) (*userpbv1.CreateUserResponse, error) {
+	var res *userpbv1.CreateUserResponse
+	err := c.call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.UserServiceClient.CreateUser(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+func (c *ResilientUserClient) ChangeUser(ctx context.Context, in *userpbv1.ChangeUserRequest, opts ...grpc.CallOption) (*userpbv1.GetUserByIdResponse, error) {
+	var res *userpbv1.GetUserByIdResponse
+	err := c.call(ctx, false, func(ctx context.Context) (err error) {
+		res, err = c.UserServiceClient.ChangeUser(ctx, in, opts...)
+		return err
+	})
+	return res, err
+}
+
+// call выполняет fn через выключатель; при retry повторяет временные ошибки.
+func (c *ResilientUserClient) call(ctx context.Context, retry bool, fn func(context.Context) error) error {
+	if !c.allow() {
+		c.stats.Rejected.Add(1)
+		return ErrUserServiceUnavailable
+	}
+
+	attempts := 1
+	if retry && c.MaxAttempts > 1 {
+		attempts = c.MaxAttempts
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		c.stats.Calls.Add(1)
+		callCtx, cancel := context.WithTimeout(ctx, c.Timeout)
+		err = fn(callCtx)
+		cancel()
+		if err == nil || !transient(err) || attempt >= attempts || ctx.Err() != nil {
+			break
+		}
+		c.stats.Retries.Add(1)
+		trace.SpanFromContext(ctx).AddEvent("user service retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt),
+			attribute.String("error", err.Error()),
+		))
+		select {
+		case <-time.After(c.retryDelay(attempt)):
+		case <-ctx.Done():
+		}
+	}
+
+	if ctx.Err() != nil {
+		// Вызов прерван вызывающим: о состоянии сервиса пользователей это ничего не говорит.
+		c.abandon()
+		return err
+	}
+	if err != nil && transient(err) {
+		c.stats.Failures.Add(1)
+		c.failure()
+		return fmt.Errorf("%w: %v", ErrUserServiceUnavailable, err)
+	}
+	c.success()
+	return err
+}
+
+// retryDelay возвращает задержку перед повтором: экспоненциальную от BaseDelay
+// до MaxDelay со случайным джиттером («full jitter»).
+func (c *ResilientUserClient) retryDelay(attempt int) time.Duration {
+	d := c.BaseDelay << (attempt - 1)
+	if d <= 0 || d > c.MaxDelay {
+		d = c.MaxDelay
+	}
+	return rand.N(d) + 1
+}
+
+// transient сообщает, имеет ли смысл повторять вызов после ошибки err.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (c *ResilientUserClient) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.openUntil.IsZero() {
+		return true
+	}
+	if time.Now().Before(c.openUntil) || c.probing {
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+func (c *ResilientUserClient) success() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = 0
+	c.openUntil = time.Time{}
+	c.probing = false
+}
+
+func (c *ResilientUserClient) abandon() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.probing = false
+}
+
+func (c *ResilientUserClient) failure() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures++
+	if c.probing || c.failures >= c.BreakerThreshold {
+		if c.openUntil.IsZero() || c.probing {
+			c.stats.BreakerOpens.Add(1)
+		}
+		c.openUntil = time.Now().Add(c.BreakerCooldown)
+		c.probing = false
+	}
+}
@@ -0,0 +1,264 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	userpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeUserService отвечает на вызовы ошибками из errs по порядку; когда они
+// заканчиваются, вызовы успешны.
+type fakeUserService struct {
+	userpbv1.UserServiceClient
+
+	errs  []error
+	calls int
+}
+
+func (f *fakeUserService) next() error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+func (f *fakeUserService) GetUserById(context.Context, *userpbv1.GetUserByIdRequest, ...grpc.CallOption) (*userpbv1.GetUserByIdResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &userpbv1.GetUserByIdResponse{}, nil
+}
+
+func (f *fakeUserService) CreateUser(context.Context, *userpbv1.CreateUserRequest, ...grpc.CallOption) (*userpbv1.CreateUserResponse, error) {
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+	return &userpbv1.CreateUserResponse{}, nil
+}
+
+func newTestUserClient(f *fakeUserService) *ResilientUserClient {
+	c := NewResilientUserClient(f)
+	c.BaseDelay = time.Microsecond
+	c.MaxDelay = time.Microsecond
+	c.BreakerThreshold = 2
+	c.BreakerCooldown = time.Hour
+	return c
+}
+
+type userStats struct {
+	Calls, Retries, Failures, Rejected, BreakerOpens int64
+}
+
+func statsOf(c *ResilientUserClient) userStats {
+	s := c.Stats()
+	return userStats{
+		Calls:        s.Calls.Load(),
+		Retries:      s.Retries.Load(),
+		Failures:     s.Failures.Load(),
+		Rejected:     s.Rejected.Load(),
+		BreakerOpens: s.BreakerOpens.Load(),
+	}
+}
+
+func getUser(c *ResilientUserClient) error {
+	_, err := c.GetUserById(context.Background(), &userpbv1.GetUserByIdRequest{})
+	return err
+}
+
+func TestResilientUserClientRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      codes.Code
+		calls     int
+		transient bool
+	}{
+		{"unavailable", codes.Unavailable, 3, true},
+		{"deadline exceeded", codes.DeadlineExceeded, 3, true},
+		{"resource exhausted", codes.ResourceExhausted, 1, false},
+		{"aborted", codes.Aborted, 1, false},
+		{"not found", codes.NotFound, 1, false},
+		{"invalid argument", codes.InvalidArgument, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeUserService{errs: []error{
+				status.Error(tt.code, "x"), status.Error(tt.code, "x"), status.Error(tt.code, "x"),
+			}}
+			c := newTestUserClient(f)
+			err := getUser(c)
+			if f.calls != tt.calls {
+				t.Errorf("calls = %d, want %d", f.calls, tt.calls)
+			}
+			if got := errors.Is(err, ErrUserServiceUnavailable); got != tt.transient {
+				t.Errorf("errors.Is(%v, ErrUserServiceUnavailable) = %t, want %t", err, got, tt.transient)
+			}
+			if !tt.transient && status.Code(err) != tt.code {
+				t.Errorf("status.Code(err) = %s, want %s", status.Code(err), tt.code)
+			}
+		})
+	}
+
+	t.Run("recovers on retry", func(t *testing.T) {
+		f := &fakeUserService{errs: []error{status.Error(codes.Unavailable, "x")}}
+		c := newTestUserClient(f)
+		if err := getUser(c); err != nil {
+			t.Fatalf("GetUserById() = %v, want nil", err)
+		}
+		if f.calls != 2 {
+			t.Errorf("calls = %d, want 2", f.calls)
+		}
+	})
+
+	t.Run("mutations are not retried", func(t *testing.T) {
+		f := &fakeUserService{errs: []error{status.Error(codes.Unavailable, "x")}}
+		c := newTestUserClient(f)
+		_, err := c.CreateUser(context.Background(), &userpbv1.CreateUserRequest{})
+		if !errors.Is(err, ErrUserServiceUnavailable) {
+			t.Fatalf("CreateUser() = %v, want ErrUserServiceUnavailable", err)
+		}
+		if f.calls != 1 {
+			t.Errorf("calls = %d, want 1", f.calls)
+		}
+	})
+}
+
+func TestResilientUserClientBreaker(t *testing.T) {
+	unavailable := func(n int) []error {
+		errs := make([]error, n)
+		for i := range errs {
+			errs[i] = status.Error(codes.Unavailable, "x")
+		}
+		return errs
+	}
+	// expire переводит разомкнутый выключатель в состояние пробного вызова.
+	expire := func(c *ResilientUserClient) {
+		c.mu.Lock()
+		c.openUntil = time.Now().Add(-time.Millisecond)
+		c.mu.Unlock()
+	}
+
+	tests := []struct {
+		name string
+		// errs — ответы сервиса; каждый неудачный вызов клиента тратит MaxAttempts ответов.
+		errs []error
+		run  func(t *testing.T, c *ResilientUserClient, f *fakeUserService)
+		open bool
+		want userStats
+	}{
+		{
+			name: "opens after threshold",
+			errs: unavailable(6),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				if c.BreakerOpen() {
+					t.Fatal("breaker open after one failure, want closed")
+				}
+				getUser(c)
+			},
+			open: true,
+			want: userStats{Calls: 6, Retries: 4, Failures: 2, BreakerOpens: 1},
+		},
+		{
+			name: "success resets failures",
+			errs: append(unavailable(3), nil),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				getUser(c)
+				f.errs = unavailable(3)
+				getUser(c)
+			},
+			open: false,
+			want: userStats{Calls: 7, Retries: 4, Failures: 2},
+		},
+		{
+			name: "rejects while open",
+			errs: unavailable(6),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				getUser(c)
+				calls := f.calls
+				for range 3 {
+					if err := getUser(c); !errors.Is(err, ErrUserServiceUnavailable) {
+						t.Fatalf("GetUserById() = %v, want ErrUserServiceUnavailable", err)
+					}
+				}
+				if f.calls != calls {
+					t.Errorf("calls while open = %d, want 0", f.calls-calls)
+				}
+			},
+			open: true,
+			want: userStats{Calls: 6, Retries: 4, Failures: 2, Rejected: 3, BreakerOpens: 1},
+		},
+		{
+			name: "successful probe closes",
+			errs: unavailable(6),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				getUser(c)
+				expire(c)
+				if err := getUser(c); err != nil {
+					t.Fatalf("probe = %v, want nil", err)
+				}
+				if err := getUser(c); err != nil {
+					t.Fatalf("GetUserById() after probe = %v, want nil", err)
+				}
+			},
+			open: false,
+			want: userStats{Calls: 8, Retries: 4, Failures: 2, BreakerOpens: 1},
+		},
+		{
+			name: "failed probe reopens",
+			errs: unavailable(9),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				getUser(c)
+				expire(c)
+				if err := getUser(c); !errors.Is(err, ErrUserServiceUnavailable) {
+					t.Fatalf("probe = %v, want ErrUserServiceUnavailable", err)
+				}
+			},
+			open: true,
+			want: userStats{Calls: 9, Retries: 6, Failures: 3, BreakerOpens: 2},
+		},
+		{
+			name: "single probe at a time",
+			errs: unavailable(6),
+			run: func(t *testing.T, c *ResilientUserClient, f *fakeUserService) {
+				getUser(c)
+				getUser(c)
+				expire(c)
+				if !c.allow() {
+					t.Fatal("allow() after cooldown = false, want true")
+				}
+				if err := getUser(c); !errors.Is(err, ErrUserServiceUnavailable) {
+					t.Fatalf("GetUserById() during probe = %v, want ErrUserServiceUnavailable", err)
+				}
+				c.success()
+			},
+			open: false,
+			want: userStats{Calls: 6, Retries: 4, Failures: 2, Rejected: 1, BreakerOpens: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &fakeUserService{errs: tt.errs}
+			c := newTestUserClient(f)
+			tt.run(t, c, f)
+			if got := c.BreakerOpen(); got != tt.open {
+				t.Errorf("BreakerOpen() = %t, want %t", got, tt.open)
+			}
+			if got := statsOf(c); got != tt.want {
+				t.Errorf("Stats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	commonpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/common/v1"
+	userpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/user/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/auth"
+	"github.com/Ostap00034/course-work-backend-order-service/ent"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultUserCacheTTL      = 5 * time.Minute
+	DefaultUserLookupTimeout = 2 * time.Second
+	// DefaultUserLookupConcurrency — сколько запросов к сервису пользователей
+	// выполняется одновременно при обогащении одного ответа.
+	DefaultUserLookupConcurrency = 8
+	// maxCachedUsers — размер кэша, после которого из него вычищаются устаревшие записи.
+	maxCachedUsers = 10000
+)
+
+var tracer = otel.Tracer("github.com/Ostap00034/course-work-backend-order-service/internal")
+
+type cachedUser struct {
+	user    *commonpbv1.UserData
+	expires time.Time
+}
+
+// UserResolver подставляет в заказы данные клиентов и исполнителей из сервиса
+// пользователей. Пользователи запрашиваются параллельно (не более concurrency
+// запросов сразу) в пределах timeout и кэшируются на ttl. Если сервис
+// пользователей недоступен, пользователь возвращается только с ID.
+type UserResolver struct {
+	client      userpbv1.UserServiceClient
+	ttl         time.Duration
+	timeout     time.Duration
+	concurrency int
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedUser
+}
+
+func NewUserResolver(client userpbv1.UserServiceClient, ttl, timeout time.Duration, concurrency int) *UserResolver {
+	if concurrency <= 0 {
+		concurrency = DefaultUserLookupConcurrency
+	}
+	return &UserResolver{
+		client:      client,
+		ttl:         ttl,
+		timeout:     timeout,
+		concurrency: concurrency,
+		cache:       make(map[uuid.UUID]cachedUser),
+	}
+}
+
+// Resolve возвращает данные пользователей по ID. Результат содержит все
+// непустые ID из ids; не найденные пользователи представлены только ID.
+func (r *UserResolver) Resolve(ctx context.Context, ids []uuid.UUID) map[uuid.UUID]*commonpbv1.UserData {
+	ctx, span := tracer.Start(ctx, "UserResolver.Resolve")
+	defer span.End()
+
+	users := make(map[uuid.UUID]*commonpbv1.UserData, len(ids))
+	var missing []uuid.UUID
+
+	now := time.Now()
+	r.mu.Lock()
+	for _, id := range ids {
+		if id == uuid.Nil {
+			continue
+		}
+		if _, ok := users[id]; ok {
+			continue
+		}
+		if c, ok := r.cache[id]; ok && now.Before(c.expires) {
+			users[id] = c.user
+			continue
+		}
+		users[id] = nil
+		missing = append(missing, id)
+	}
+	r.mu.Unlock()
+
+	span.SetAttributes(
+		attribute.Int("users.requested", len(users)),
+		attribute.Int("users.cache_misses", len(missing)),
+	)
+	if len(missing) > 0 {
+		r.fetch(ctx, missing, users)
+	}
+	for id, u := range users {
+		if u == nil {
+			users[id] = &commonpbv1.UserData{Id: id.String()}
+		}
+	}
+	return users
+}
+
+// Get возвращает пользователя по ID. В отличие от Resolve ошибки не скрываются:
+// ErrUserNotFound, если пользователя нет, и ErrUserServiceUnavailable, если
+// сервис пользователей не ответил.
+func (r *UserResolver) Get(ctx context.Context, id uuid.UUID) (*commonpbv1.UserData, error) {
+	ctx, span := tracer.Start(ctx, "UserResolver.Get", trace.WithAttributes(attribute.String("user.id", id.String())))
+	defer span.End()
+
+	r.mu.Lock()
+	c, ok := r.cache[id]
+	r.mu.Unlock()
+	if ok && time.Now().Before(c.expires) {
+		return c.user, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	res, err := r.client.GetUserById(ctx, &userpbv1.GetUserByIdRequest{UserId: id.String()})
+	switch {
+	case err == nil && res.User != nil:
+		r.store(id, res.User)
+		return res.User, nil
+	case err == nil, status.Code(err) == codes.NotFound:
+		return nil, ErrUserNotFound
+	case errors.Is(err, ErrUserServiceUnavailable):
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: %v", ErrUserServiceUnavailable, err)
+}
+
+// GetMaster возвращает исполнителя по ID: как Get, но пользователь с другой
+// ролью даёт ErrUserNotMaster.
+func (r *UserResolver) GetMaster(ctx context.Context, id uuid.UUID) (*commonpbv1.UserData, error) {
+	u, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u.Role != string(auth.RoleMaster) {
+		return nil, ErrUserNotMaster
+	}
+	return u, nil
+}
+
+// fetch запрашивает пользователей ids и записывает найденных в users и кэш.
+func (r *UserResolver) fetch(ctx context.Context, ids []uuid.UUID, users map[uuid.UUID]*commonpbv1.UserData) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, r.concurrency)
+	)
+loop:
+	for _, id := range ids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		wg.Add(1)
+		go func(id uuid.UUID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			res, err := r.client.GetUserById(ctx, &userpbv1.GetUserByIdRequest{UserId: id.String()})
+			if err != nil || res.User == nil {
+				if err != nil {
+					log.Printf("user lookup %s failed: %v", id, err)
+				}
+				return
+			}
+			mu.Lock()
+			users[id] = res.User
+			mu.Unlock()
+			r.store(id, res.User)
+		}(id)
+	}
+	wg.Wait()
+}
+
+func (r *UserResolver) store(id uuid.UUID, user *commonpbv1.UserData) {
+	now := time.Now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.cache) >= maxCachedUsers {
+		for k, c := range r.cache {
+			if !now.Before(c.expires) {
+				delete(r.cache, k)
+			}
+		}
+	}
+	r.cache[id] = cachedUser{user: user, expires: now.Add(r.ttl)}
+}
+
+// OrdersData переводит заказы в модель API с данными клиентов и исполнителей.
+func (r *UserResolver) OrdersData(ctx context.Context, orders []*ent.Order) []*commonpbv1.OrderData {
+	ids := make([]uuid.UUID, 0, 2*len(orders))
+	for _, o := range orders {
+		ids = append(ids, o.ClientID, o.MasterID)
+	}
+	users := r.Resolve(ctx, ids)
+
+	out := make([]*commonpbv1.OrderData, len(orders))
+	for i, o := range orders {
+		out[i] = orderData(o)
+		out[i].Client = users[o.ClientID]
+		out[i].Master = users[o.MasterID]
+	}
+	return out
+}
+
+// OrderData переводит заказ в модель API с данными клиента и исполнителя.
+func (r *UserResolver) OrderData(ctx context.Context, o *ent.Order) *commonpbv1.OrderData {
+	return r.OrdersData(ctx, []*ent.Order{o})[0]
+}
@@ -0,0 +1,239 @@
+package order
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
+	"github.com/Ostap00034/course-work-backend-order-service/ent/order"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Ограничения полей заказа. Длины считаются в символах.
+const (
+	MaxTitleLen       = 200
+	MaxDescriptionLen = 5000
+	MinAddressLen     = 5
+	MaxAddressLen     = 300
+	MaxOrderPrice     = 100_000_000
+)
+
+// ValidationError — все найденные ошибки в полях запроса. Переводится в
+// InvalidArgument с деталями google.rpc.BadRequest.
+type ValidationError struct {
+	Violations []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Field + ": " + v.Message
+	}
+	return "неправильные поля запроса: " + strings.Join(msgs, "; ")
+}
+
+// badRequest возвращает нарушения в виде google.rpc.BadRequest.
+func (e *ValidationError) badRequest() *errdetails.BadRequest {
+	br := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Message,
+		})
+	}
+	return br
+}
+
+// validator собирает ошибки полей, чтобы вернуть их все сразу.
+type validator struct {
+	violations []FieldError
+}
+
+func (v *validator) add(field, message string) {
+	v.violations = append(v.violations, FieldError{Field: field, Message: message})
+}
+
+func (v *validator) check(ok bool, field, message string) {
+	if !ok {
+		v.add(field, message)
+	}
+}
+
+// err возвращает *ValidationError, если нашлись ошибки, иначе nil.
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	return &ValidationError{Violations: v.violations}
+}
+
+// uuid разбирает обязательный UUID поля field.
+func (v *validator) uuid(field, s string) uuid.UUID {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		v.add(field, "неправильный формат UUID")
+	}
+	return id
+}
+
+// text проверяет текстовое поле и возвращает его без пробелов по краям.
+// Пустое значение допустимо только при min == 0.
+func (v *validator) text(field, s string, min, max int) string {
+	s = strings.TrimSpace(s)
+	n := utf8.RuneCountInString(s)
+	switch {
+	case !utf8.ValidString(s):
+		v.add(field, "недопустимые символы")
+	case n == 0 && min > 0:
+		v.add(field, "поле не заполнено")
+	case n < min:
+		v.add(field, "слишком короткое значение")
+	case n > max:
+		v.add(field, "слишком длинное значение")
+	case strings.IndexFunc(s, isForbiddenRune) >= 0:
+		v.add(field, "недопустимые символы")
+	}
+	return s
+}
+
+// isForbiddenRune запрещает управляющие символы, кроме переводов строк и табуляции.
+func isForbiddenRune(r rune) bool {
+	return unicode.IsControl(r) && r != '\n' && r != '\r' && r != '\t'
+}
+
+// address проверяет адрес: одна строка, в которой есть буквы.
+func (v *validator) address(s string) string {
+	s = v.text("address", s, MinAddressLen, MaxAddressLen)
+	if utf8.RuneCountInString(s) < MinAddressLen {
+		return s
+	}
+	switch {
+	case strings.ContainsAny(s, "\n\r\t"):
+		v.add("address", "адрес должен быть одной строкой")
+	case strings.IndexFunc(s, unicode.IsLetter) < 0:
+		v.add("address", "адрес должен содержать название улицы или населённого пункта")
+	}
+	return s
+}
+
+func (v *validator) price(p float32) {
+	// Сравнения записаны так, чтобы NaN не проходил проверку.
+	v.check(p >= 0, "price", "цена не может быть отрицательной")
+	v.check(!(p > MaxOrderPrice), "price", "цена слишком велика")
+}
+
+// coordinate разбирает координату и проверяет, что она в пределах [-limit, limit].
+func (v *validator) coordinate(field, s string, limit float64) float64 {
+	c, err := parseCoordinate(s)
+	if err != nil {
+		v.add(field, "ожидается число")
+		return 0
+	}
+	if !(c >= -limit && c <= limit) {
+		v.add(field, "значение вне допустимого диапазона")
+	}
+	return c
+}
+
+// orderInput — проверенные поля заказа из запроса.
+type orderInput struct {
+	Title       string
+	Description string
+	Address     string
+	Longitude   float64
+	Latitude    float64
+	Price       float32
+	Status      string
+	CategoryID  uuid.UUID
+	ClientID    uuid.UUID
+}
+
+// validateCreateOrder проверяет запрос на создание заказа.
+func validateCreateOrder(req *orderpbv1.CreateOrderRequest) (*orderInput, error) {
+	var v validator
+	in := &orderInput{
+		ClientID:    v.uuid("client_id", req.ClientId),
+		CategoryID:  v.uuid("category_id", req.CategoryId),
+		Title:       v.text("title", req.Title, 1, MaxTitleLen),
+		Description: v.text("description", req.Description, 0, MaxDescriptionLen),
+		Address:     v.address(req.Address),
+		Price:       req.Price,
+		Status:      req.Status,
+	}
+	v.price(req.Price)
+	v.check(req.Longitude != "", "longitude", "поле не заполнено")
+	v.check(req.Latitude != "", "latitude", "поле не заполнено")
+	in.Longitude = v.coordinate("longitude", req.Longitude, 180)
+	in.Latitude = v.coordinate("latitude", req.Latitude, 90)
+	v.check(req.Status == "" || req.Status == order.StatusActive.String(), "status",
+		"новый заказ может иметь только статус active")
+	return in, v.err()
+}
+
+// validateUpdateOrder проверяет запрос на изменение заказа и возвращает патч.
+// С маской mask меняются ровно перечисленные поля, в том числе на пустые
+// значения. Без маски пустые поля означают, что значение не меняется, а
+// client_id не учитывается. Исполнитель через обновление не меняется.
+func validateUpdateOrder(req *orderpbv1.UpdateOrderRequest, mask *fieldmaskpb.FieldMask) (*OrderPatch, error) {
+	var v validator
+	patch := &OrderPatch{}
+	if mask != nil {
+		mask.Normalize()
+		v.checkMask(mask)
+		for _, path := range mask.GetPaths() {
+			if updatablePaths[path] {
+				patch.set(path)
+			}
+		}
+	} else {
+		for path, changed := range map[string]bool{
+			PathTitle:       req.Title != "",
+			PathDescription: req.Description != "",
+			PathAddress:     req.Address != "",
+			PathLongitude:   req.Longitude != "",
+			PathLatitude:    req.Latitude != "",
+			PathStatus:      req.Status != "",
+			PathPrice:       req.Price != 0,
+			PathCategoryID:  req.CategoryId != "",
+		} {
+			if changed {
+				patch.set(path)
+			}
+		}
+		v.check(req.MasterId == "", PathMasterID, managedPaths[PathMasterID])
+	}
+
+	if patch.Has(PathTitle) {
+		patch.Title = v.text("title", req.Title, 1, MaxTitleLen)
+	}
+	if patch.Has(PathDescription) {
+		patch.Description = v.text("description", req.Description, 0, MaxDescriptionLen)
+	}
+	if patch.Has(PathAddress) {
+		patch.Address = v.address(req.Address)
+	}
+	if patch.Has(PathLongitude) {
+		v.check(req.Longitude != "", "longitude", "поле не заполнено")
+		patch.Longitude = v.coordinate("longitude", req.Longitude, 180)
+	}
+	if patch.Has(PathLatitude) {
+		v.check(req.Latitude != "", "latitude", "поле не заполнено")
+		patch.Latitude = v.coordinate("latitude", req.Latitude, 90)
+	}
+	if patch.Has(PathStatus) {
+		_, err := parseStatus(req.Status)
+		v.check(err == nil, "status", "допустимые статусы: active, in_progress, cancel, done")
+		patch.Status = req.Status
+	}
+	if patch.Has(PathPrice) {
+		v.price(req.Price)
+		patch.Price = req.Price
+	}
+	if patch.Has(PathCategoryID) {
+		patch.CategoryID = v.uuid("category_id", req.CategoryId)
+	}
+	return patch, v.err()
+}
@@ -0,0 +1,215 @@
+package order
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	orderpbv1 "github.com/Ostap00034/course-work-backend-api-specs/gen/go/order/v1"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// violatedFields возвращает поля из ошибки валидации в порядке обнаружения.
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("error = %v, want *ValidationError", err)
+	}
+	fields := make([]string, len(verr.Violations))
+	for i, v := range verr.Violations {
+		fields[i] = v.Field
+	}
+	return fields
+}
+
+func validCreateRequest() *orderpbv1.CreateOrderRequest {
+	return &orderpbv1.CreateOrderRequest{
+		Title:       "Собрать шкаф",
+		Description: "Шкаф-купе, две двери",
+		Address:     "Москва, ул. Ленина, 1",
+		Longitude:   "37,6173",
+		Latitude:    "55.7558",
+		Price:       3000,
+		CategoryId:  uuid.NewString(),
+		ClientId:    uuid.NewString(),
+	}
+}
+
+func TestValidateCreateOrder(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(r *orderpbv1.CreateOrderRequest)
+		want   []string
+	}{
+		{"valid", func(r *orderpbv1.CreateOrderRequest) {}, nil},
+		{"empty description", func(r *orderpbv1.CreateOrderRequest) { r.Description = "" }, nil},
+		{"explicit active status", func(r *orderpbv1.CreateOrderRequest) { r.Status = "active" }, nil},
+		{"blank title", func(r *orderpbv1.CreateOrderRequest) { r.Title = "   " }, []string{"title"}},
+		{"long title", func(r *orderpbv1.CreateOrderRequest) { r.Title = strings.Repeat("я", MaxTitleLen+1) }, []string{"title"}},
+		{"control characters", func(r *orderpbv1.CreateOrderRequest) { r.Description = "a\x00b" }, []string{"description"}},
+		{"short address", func(r *orderpbv1.CreateOrderRequest) { r.Address = "д1" }, []string{"address"}},
+		{"multiline address", func(r *orderpbv1.CreateOrderRequest) { r.Address = "Москва\nЛенина 1" }, []string{"address"}},
+		{"address without letters", func(r *orderpbv1.CreateOrderRequest) { r.Address = "12345, 6" }, []string{"address"}},
+		{"negative price", func(r *orderpbv1.CreateOrderRequest) { r.Price = -1 }, []string{"price"}},
+		{"huge price", func(r *orderpbv1.CreateOrderRequest) { r.Price = MaxOrderPrice * 2 }, []string{"price"}},
+		{"NaN price", func(r *orderpbv1.CreateOrderRequest) { r.Price = float32(math.NaN()) }, []string{"price"}},
+		{"missing coordinates", func(r *orderpbv1.CreateOrderRequest) { r.Longitude, r.Latitude = "", "" }, []string{"longitude", "latitude"}},
+		{"coordinates out of range", func(r *orderpbv1.CreateOrderRequest) { r.Longitude, r.Latitude = "181", "-90.5" }, []string{"longitude", "latitude"}},
+		{"coordinate not a number", func(r *orderpbv1.CreateOrderRequest) { r.Latitude = "north" }, []string{"latitude"}},
+		{"bad ids", func(r *orderpbv1.CreateOrderRequest) { r.ClientId, r.CategoryId = "me", "" }, []string{"client_id", "category_id"}},
+		{"non-active status", func(r *orderpbv1.CreateOrderRequest) { r.Status = "done" }, []string{"status"}},
+		{"everything wrong", func(r *orderpbv1.CreateOrderRequest) {
+			*r = orderpbv1.CreateOrderRequest{Price: -5, Status: "in_progress"}
+		}, []string{"client_id", "category_id", "title", "address", "price", "longitude", "latitude", "status"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validCreateRequest()
+			tt.modify(req)
+			in, err := validateCreateOrder(req)
+			if got := violatedFields(t, err); !slices.Equal(got, tt.want) {
+				t.Fatalf("violations = %v, want %v (%v)", got, tt.want, err)
+			}
+			if err == nil && (in.Longitude != 37.6173 || in.Latitude != 55.7558) {
+				t.Errorf("coordinates = %v, %v, want parsed values", in.Longitude, in.Latitude)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateOrderWithoutMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *orderpbv1.UpdateOrderRequest
+		paths []string
+		want  []string
+	}{
+		{"nothing", &orderpbv1.UpdateOrderRequest{}, nil, nil},
+		{"non-empty fields only", &orderpbv1.UpdateOrderRequest{Title: " Новое ", Price: 10}, []string{PathPrice, PathTitle}, nil},
+		{"client_id ignored", &orderpbv1.UpdateOrderRequest{ClientId: "whatever", Status: "done"}, []string{PathStatus}, nil},
+		{"master_id rejected", &orderpbv1.UpdateOrderRequest{MasterId: uuid.NewString()}, nil, []string{"master_id"}},
+		{"invalid values", &orderpbv1.UpdateOrderRequest{Title: strings.Repeat("x", MaxTitleLen+1), Status: "closed", CategoryId: "cat"},
+			[]string{PathCategoryID, PathStatus, PathTitle}, []string{"title", "status", "category_id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := validateUpdateOrder(tt.req, nil)
+			if got := violatedFields(t, err); !slices.Equal(got, tt.want) {
+				t.Fatalf("violations = %v, want %v (%v)", got, tt.want, err)
+			}
+			var paths []string
+			for path := range patch.Paths {
+				paths = append(paths, path)
+			}
+			slices.Sort(paths)
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("patch paths = %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateOrderWithMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *orderpbv1.UpdateOrderRequest
+		mask  []string
+		paths []string
+		want  []string
+	}{
+		{"zero values are written", &orderpbv1.UpdateOrderRequest{}, []string{"price", "description"},
+			[]string{PathDescription, PathPrice}, nil},
+		{"fields outside mask ignored", &orderpbv1.UpdateOrderRequest{Title: "", Price: -1}, []string{"description"},
+			[]string{PathDescription}, nil},
+		{"duplicate paths", &orderpbv1.UpdateOrderRequest{Price: 5}, []string{"price", "price"}, []string{PathPrice}, nil},
+		{"empty mask", &orderpbv1.UpdateOrderRequest{Title: "x"}, []string{}, nil, []string{"update_mask"}},
+		{"immutable paths", &orderpbv1.UpdateOrderRequest{}, []string{"client_id", "version"}, nil, []string{"update_mask", "update_mask"}},
+		{"unknown path", &orderpbv1.UpdateOrderRequest{}, []string{"colour"}, nil, []string{"update_mask"}},
+		{"master_id managed separately", &orderpbv1.UpdateOrderRequest{}, []string{"master_id"}, nil, []string{"master_id"}},
+		{"required fields cannot be cleared", &orderpbv1.UpdateOrderRequest{}, []string{"title", "address", "longitude", "status", "category_id"},
+			[]string{PathAddress, PathCategoryID, PathLongitude, PathStatus, PathTitle},
+			[]string{"address", "longitude", "title", "status", "category_id"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := validateUpdateOrder(tt.req, &fieldmaskpb.FieldMask{Paths: tt.mask})
+			got := violatedFields(t, err)
+			slices.Sort(got)
+			want := slices.Sorted(slices.Values(tt.want))
+			if !slices.Equal(got, want) {
+				t.Fatalf("violations = %v, want %v (%v)", got, want, err)
+			}
+			var paths []string
+			for path := range patch.Paths {
+				paths = append(paths, path)
+			}
+			slices.Sort(paths)
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("patch paths = %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestValidationErrorStatus(t *testing.T) {
+	_, err := validateCreateOrder(&orderpbv1.CreateOrderRequest{})
+	st := status.Convert(ToStatus(err))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+
+	var info *errdetails.ErrorInfo
+	var br *errdetails.BadRequest
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			br = d
+		}
+	}
+	if info == nil || info.Reason != "VALIDATION_FAILED" || info.Domain != ErrorDomain {
+		t.Errorf("ErrorInfo = %v, want reason VALIDATION_FAILED in %s", info, ErrorDomain)
+	}
+	if br == nil {
+		t.Fatal("no BadRequest details")
+	}
+	fields := make([]string, len(br.FieldViolations))
+	for i, v := range br.FieldViolations {
+		if v.Description == "" {
+			t.Errorf("violation of %s has no description", v.Field)
+		}
+		fields[i] = v.Field
+	}
+	for _, want := range []string{"client_id", "title", "address", "longitude"} {
+		if !slices.Contains(fields, want) {
+			t.Errorf("field violations %v, want %s", fields, want)
+		}
+	}
+}
+
+func TestFieldErrorStatus(t *testing.T) {
+	st := status.Convert(ToStatus(invalidField("reason", "укажите причину")))
+	if st.Code() != codes.InvalidArgument || st.Message() != "укажите причину" {
+		t.Fatalf("status = %v %q", st.Code(), st.Message())
+	}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			if len(br.FieldViolations) != 1 || br.FieldViolations[0].Field != "reason" {
+				t.Errorf("BadRequest = %v, want single violation of reason", br)
+			}
+			return
+		}
+	}
+	t.Error("no BadRequest details")
+}
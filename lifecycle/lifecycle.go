@@ -0,0 +1,148 @@
+// Package lifecycle управляет запуском и остановкой сервиса: gRPC-сервером,
+// фоновыми задачами и ресурсами, которые нужно закрыть при выходе.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+type closer struct {
+	name string
+	fn   func() error
+}
+
+// Manager останавливает сервис по SIGINT/SIGTERM, по ошибке gRPC-сервера или
+// фоновой задачи. Порядок остановки:
+//
+//  1. health переводится в NOT_SERVING, выполняются функции BeforeDrain;
+//  2. GracefulStop ждёт завершения запросов не дольше DrainTimeout, затем Stop;
+//  3. фоновые задачи получают отмену контекста и ждут до WorkerTimeout;
+//  4. ресурсы, добавленные через OnClose, закрываются в порядке добавления.
+type Manager struct {
+	DrainTimeout  time.Duration
+	WorkerTimeout time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	failed     chan error
+	failedOnce sync.Once
+
+	beforeDrain []func()
+	closers     []closer
+}
+
+func New(drainTimeout, workerTimeout time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		DrainTimeout:  drainTimeout,
+		WorkerTimeout: workerTimeout,
+		ctx:           ctx,
+		cancel:        cancel,
+		failed:        make(chan error, 1),
+	}
+}
+
+// Go запускает фоновую задачу. Её контекст отменяется при остановке сервиса;
+// ошибка задачи останавливает сервис.
+func (m *Manager) Go(name string, fn func(ctx context.Context) error) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := fn(m.ctx); err != nil && m.ctx.Err() == nil {
+			m.fail(errors.New(name + ": " + err.Error()))
+		}
+	}()
+}
+
+// BeforeDrain добавляет функцию, вызываемую перед ожиданием запросов: например,
+// закрытие долгих потоков, которые иначе не дадут GracefulStop завершиться.
+func (m *Manager) BeforeDrain(fn func()) {
+	m.beforeDrain = append(m.beforeDrain, fn)
+}
+
+// OnClose добавляет ресурс, закрываемый после остановки сервера и фоновых задач.
+func (m *Manager) OnClose(name string, fn func() error) {
+	m.closers = append(m.closers, closer{name: name, fn: fn})
+}
+
+func (m *Manager) fail(err error) {
+	m.failedOnce.Do(func() { m.failed <- err })
+}
+
+// Serve обслуживает lis до сигнала остановки или ошибки, затем останавливает
+// сервис. Возвращает причину остановки, если это не сигнал.
+func (m *Manager) Serve(srv *grpc.Server, lis net.Listener, hs *health.Server) error {
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			m.fail(err)
+		}
+	}()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	var cause error
+	select {
+	case s := <-sig:
+		log.Printf("received %s, shutting down", s)
+	case cause = <-m.failed:
+		log.Printf("shutting down: %v", cause)
+	}
+
+	m.shutdown(srv, hs)
+	return cause
+}
+
+func (m *Manager) shutdown(srv *grpc.Server, hs *health.Server) {
+	if hs != nil {
+		hs.Shutdown()
+	}
+	for _, fn := range m.beforeDrain {
+		fn()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(m.DrainTimeout):
+		log.Printf("drain deadline %s exceeded, stopping server", m.DrainTimeout)
+		srv.Stop()
+		<-stopped
+	}
+
+	m.cancel()
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(m.WorkerTimeout):
+		log.Printf("background workers did not stop within %s", m.WorkerTimeout)
+	}
+
+	for _, c := range m.closers {
+		if err := c.fn(); err != nil {
+			log.Printf("failed to close %s: %v", c.name, err)
+		}
+	}
+}